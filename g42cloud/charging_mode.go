@@ -0,0 +1,192 @@
+package g42cloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/common/structs"
+	"github.com/chnsz/golangsdk/openstack/networking/v2/bandwidths"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// chargingModeConverter changes a postPaid resource to prePaid and returns the ID of the order.
+type chargingModeConverter func(d *schema.ResourceData, config *config.Config) (string, error)
+
+// validateChargingModeChange checks the charging mode conversion during the plan, only the conversion from
+// postPaid to prePaid can be done in place, the other changes of the charging mode recreate the resource.
+func validateChargingModeChange(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("charging_mode") {
+		return nil
+	}
+
+	oldMode, newMode := d.GetChange("charging_mode")
+	if oldMode.(string) == "prePaid" && newMode.(string) != "prePaid" {
+		return fmt.Errorf("the charging mode cannot be changed from prePaid to postPaid in place, please disable " +
+			"`auto_renew` and change it to postPaid after expiration on the console")
+	}
+	if newMode.(string) != "prePaid" {
+		return d.ForceNew("charging_mode")
+	}
+	if _, ok := d.GetOk("period_unit"); !ok {
+		return fmt.Errorf("both of `period, period_unit` must be specified when changing to prePaid charging mode")
+	}
+	return nil
+}
+
+// makeChargingModeConvertible removes ForceNew from the charging mode and period arguments, so that the resource can
+// be changed to prePaid and renewed in place, validateChargingModeChange should be used as the CustomizeDiff.
+func makeChargingModeConvertible(r *schema.Resource) {
+	for _, key := range []string{"charging_mode", "period_unit", "period"} {
+		if s, ok := r.Schema[key]; ok {
+			s.ForceNew = false
+		}
+	}
+}
+
+// validateEvsVolumeChargingModeChange prevents the EVS volume from being recreated when its charging mode is changed.
+// There is no API to change a volume to prePaid separately, the volumes attached to an ECS instance are changed to
+// prePaid along with the instance.
+func validateEvsVolumeChargingModeChange(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("charging_mode") {
+		return nil
+	}
+
+	oldMode, newMode := d.GetChange("charging_mode")
+	if oldMode.(string) == "prePaid" {
+		return fmt.Errorf("the EVS volume (%s) is prePaid, it may be changed along with the ECS instance to which it "+
+			"is attached, please set `charging_mode` to prePaid, it cannot be changed back to postPaid in place",
+			d.Id())
+	}
+	if newMode.(string) == "prePaid" {
+		return fmt.Errorf("the EVS volume (%s) cannot be changed to prePaid separately, please change the ECS "+
+			"instance to which it is attached to prePaid, the volume is changed along with the instance", d.Id())
+	}
+	return nil
+}
+
+// convertChargingMode changes the resource to prePaid and waits for the order to be completed.
+func convertChargingMode(ctx context.Context, d *schema.ResourceData, config *config.Config,
+	convert chargingModeConverter) error {
+	if err := validatePrePaidChargeInfo(d); err != nil {
+		return err
+	}
+
+	bssV2Client, err := config.BssV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud BSS v2 client: %s", err)
+	}
+
+	orderID, err := convert(d, config)
+	if err != nil {
+		return fmt.Errorf("error changing the charging mode of the resource (%s) to prePaid: %s", d.Id(), err)
+	}
+	return waitForOrdersComplete(ctx, bssV2Client, []string{orderID}, d.Timeout(schema.TimeoutUpdate))
+}
+
+// withChargingModeConversion makes the charging mode of the resource convertible from postPaid to prePaid in place,
+// the conversion is done before the other changes are updated. The prePaid resource will also be renewed if
+// `period_unit` or `period` is changed.
+func withChargingModeConversion(r *schema.Resource, convert chargingModeConverter) *schema.Resource {
+	makeChargingModeConvertible(r)
+
+	update := r.UpdateContext
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		config := meta.(*config.Config)
+		if d.HasChange("charging_mode") {
+			if err := convertChargingMode(ctx, d, config, convert); err != nil {
+				return diag.FromErr(err)
+			}
+		} else if d.Get("charging_mode").(string) == "prePaid" && d.HasChanges("period_unit", "period") {
+			if err := renewPrePaidPeriod(ctx, d, config, d.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return update(ctx, d, meta)
+	}
+
+	return withCustomizeDiff(r, validateChargingModeChange)
+}
+
+func buildEcsInstanceChargingModeChangeBody(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"server_ids":  []string{d.Id()},
+		"charge_mode": "prePaid",
+		"prepaid_options": map[string]interface{}{
+			"period_type":        d.Get("period_unit").(string),
+			"period_num":         d.Get("period").(int),
+			"auto_pay":           true,
+			"auto_renew":         d.Get("auto_renew").(string) == "true",
+			"include_data_disks": true,
+		},
+	}
+}
+
+func doChargingModeChange(client *golangsdk.ServiceClient, httpUrl string, body map[string]interface{}) (string,
+	error) {
+	path := client.Endpoint + strings.ReplaceAll(httpUrl, "{project_id}", client.ProjectID)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         body,
+		OkCodes:          []int{200, 202},
+	}
+
+	log.Printf("[DEBUG] Charging mode change options: %#v", body)
+	resp, err := client.Request("POST", path, &opts)
+	if err != nil {
+		return "", err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	orderID := utils.PathSearch("order_id", respBody, "").(string)
+	if orderID == "" {
+		return "", fmt.Errorf("the order ID is not found in the response")
+	}
+	return orderID, nil
+}
+
+// convertEcsInstanceToPrePaid changes a postPaid ECS instance to prePaid, the data disks attached to the instance are
+// changed along with it.
+func convertEcsInstanceToPrePaid(d *schema.ResourceData, config *config.Config) (string, error) {
+	client, err := config.NewServiceClient("ecs", GetRegion(d, config))
+	if err != nil {
+		return "", fmt.Errorf("error creating G42Cloud ECS client: %s", err)
+	}
+
+	httpUrl := "v1/{project_id}/cloudservers/actions/change-charge-mode"
+	return doChargingModeChange(client, httpUrl, buildEcsInstanceChargingModeChangeBody(d))
+}
+
+// convertEipToPrePaid changes a postPaid EIP to prePaid by changing its dedicated bandwidth.
+func convertEipToPrePaid(d *schema.ResourceData, config *config.Config) (string, error) {
+	if shareType := d.Get("bandwidth.0.share_type").(string); shareType != "PER" {
+		return "", fmt.Errorf("only the EIP with a dedicated bandwidth can be changed to prePaid, "+
+			"but the share type is %s", shareType)
+	}
+
+	client, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return "", fmt.Errorf("error creating G42Cloud networking v2 client: %s", err)
+	}
+
+	changeOpts := bandwidths.ChangeToPeriodOpts{
+		BandwidthIDs: []string{d.Get("bandwidth.0.id").(string)},
+		ExtendParam: structs.ChargeInfo{
+			ChargeMode:  "prePaid",
+			PeriodType:  d.Get("period_unit").(string),
+			PeriodNum:   d.Get("period").(int),
+			IsAutoRenew: d.Get("auto_renew").(string),
+			IsAutoPay:   "true",
+		},
+	}
+	return bandwidths.ChangeToPeriod(client, changeOpts).Extract()
+}
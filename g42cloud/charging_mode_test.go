@@ -0,0 +1,253 @@
+package g42cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+)
+
+func testChargingModeResource() *schema.Resource {
+	return &schema.Resource{
+		UpdateContext: func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name":          {Type: schema.TypeString, Optional: true},
+			"charging_mode": schemaChargingMode(nil),
+			"period_unit":   schemaPeriodUnit(nil),
+			"period":        schemaPeriod(nil),
+			"auto_renew":    schemaAutoRenew(nil),
+		},
+	}
+}
+
+func TestValidateChargingModeChange(t *testing.T) {
+	r := withChargingModeConversion(testChargingModeResource(), nil)
+	for _, key := range []string{"charging_mode", "period_unit", "period"} {
+		if r.Schema[key].ForceNew {
+			t.Fatalf("%s should not be ForceNew after the conversion is enabled", key)
+		}
+	}
+
+	prePaid := map[string]string{
+		"id":            "test",
+		"charging_mode": "prePaid",
+		"period_unit":   "month",
+		"period":        "1",
+	}
+	postPaid := map[string]string{
+		"id":            "test",
+		"charging_mode": "postPaid",
+	}
+	cases := []struct {
+		name        string
+		state       map[string]string
+		config      map[string]interface{}
+		expectErr   bool
+		requiresNew bool
+	}{
+		{
+			name:  "create prePaid",
+			state: nil,
+			config: map[string]interface{}{
+				"charging_mode": "prePaid", "period_unit": "month", "period": 1,
+			},
+		},
+		{
+			name:  "postPaid to prePaid",
+			state: postPaid,
+			config: map[string]interface{}{
+				"charging_mode": "prePaid", "period_unit": "month", "period": 1,
+			},
+		},
+		{
+			name:      "postPaid to prePaid without period",
+			state:     postPaid,
+			config:    map[string]interface{}{"charging_mode": "prePaid"},
+			expectErr: true,
+		},
+		{
+			name:      "prePaid to postPaid",
+			state:     prePaid,
+			config:    map[string]interface{}{"charging_mode": "postPaid"},
+			expectErr: true,
+		},
+		{
+			name:  "postPaid to spot",
+			state: postPaid,
+			config: map[string]interface{}{
+				"charging_mode": "spot",
+			},
+			requiresNew: true,
+		},
+		{
+			name:  "change period of prePaid",
+			state: prePaid,
+			config: map[string]interface{}{
+				"charging_mode": "prePaid", "period_unit": "month", "period": 2,
+			},
+		},
+		{
+			name:  "change auto_renew of prePaid",
+			state: prePaid,
+			config: map[string]interface{}{
+				"charging_mode": "prePaid", "period_unit": "month", "period": 1, "auto_renew": "true",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		var state *terraform.InstanceState
+		if tc.state != nil {
+			state = &terraform.InstanceState{ID: tc.state["id"], Attributes: tc.state}
+		}
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+		if tc.expectErr {
+			if err == nil {
+				t.Errorf("%s: expected an error, but got nil", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+			continue
+		}
+		if requiresNew := diff != nil && diff.RequiresNew(); requiresNew != tc.requiresNew && state != nil {
+			t.Errorf("%s: RequiresNew() = %t, expected %t", tc.name, requiresNew, tc.requiresNew)
+		}
+	}
+}
+
+func TestValidateEvsVolumeChargingModeChange(t *testing.T) {
+	r := withCustomizeDiff(testChargingModeResource(), validateEvsVolumeChargingModeChange)
+
+	cases := []struct {
+		name      string
+		state     map[string]string
+		config    map[string]interface{}
+		expectErr bool
+	}{
+		{
+			name:   "create prePaid",
+			config: map[string]interface{}{"charging_mode": "prePaid", "period_unit": "month", "period": 1},
+		},
+		{
+			name:   "changed by the instance",
+			state:  map[string]string{"id": "test", "charging_mode": "prePaid"},
+			config: map[string]interface{}{"name": "test"},
+		},
+		{
+			name:      "postPaid to prePaid",
+			state:     map[string]string{"id": "test", "charging_mode": "postPaid"},
+			config:    map[string]interface{}{"charging_mode": "prePaid", "period_unit": "month", "period": 1},
+			expectErr: true,
+		},
+		{
+			name:      "prePaid to postPaid",
+			state:     map[string]string{"id": "test", "charging_mode": "prePaid"},
+			config:    map[string]interface{}{"charging_mode": "postPaid"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		var state *terraform.InstanceState
+		if tc.state != nil {
+			state = &terraform.InstanceState{ID: tc.state["id"], Attributes: tc.state}
+		}
+		_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+		if tc.expectErr != (err != nil) {
+			t.Errorf("%s: expected error %t, but got %v", tc.name, tc.expectErr, err)
+		}
+	}
+}
+
+func TestDoChargingModeChange(t *testing.T) {
+	var requestBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1/project-1/cloudservers/actions/change-charge-mode" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"order_id": "order-1"}`)
+	}))
+	defer server.Close()
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-1"},
+		Endpoint:       server.URL + "/",
+	}
+	d := schema.TestResourceDataRaw(t, testChargingModeResource().Schema, map[string]interface{}{
+		"charging_mode": "prePaid",
+		"period_unit":   "month",
+		"period":        3,
+		"auto_renew":    "true",
+	})
+	d.SetId("server-1")
+
+	orderID, err := doChargingModeChange(client, "v1/{project_id}/cloudservers/actions/change-charge-mode",
+		buildEcsInstanceChargingModeChangeBody(d))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if orderID != "order-1" {
+		t.Fatalf("expected the order ID order-1, but got %s", orderID)
+	}
+
+	expected := map[string]interface{}{
+		"server_ids":  []interface{}{"server-1"},
+		"charge_mode": "prePaid",
+		"prepaid_options": map[string]interface{}{
+			"period_type":        "month",
+			"period_num":         float64(3),
+			"auto_pay":           true,
+			"auto_renew":         true,
+			"include_data_disks": true,
+		},
+	}
+	if !reflect.DeepEqual(requestBody, expected) {
+		t.Errorf("the request body is %v, expected %v", requestBody, expected)
+	}
+
+	if _, err := doChargingModeChange(client, "v1/{project_id}/cloudservers/server-1/actions/change-charge-mode",
+		buildEcsInstanceChargingModeChangeBody(d)); err == nil {
+		t.Fatalf("expected an error when the request fails")
+	}
+}
+
+func TestConvertEipToPrePaidWithSharedBandwidth(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"bandwidth": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"share_type": {Type: schema.TypeString, Optional: true},
+				},
+			},
+		},
+	}, map[string]interface{}{
+		"bandwidth": []interface{}{
+			map[string]interface{}{"share_type": "WHOLE"},
+		},
+	})
+
+	if _, err := convertEipToPrePaid(d, nil); err == nil {
+		t.Fatalf("expected an error when the EIP uses a shared bandwidth")
+	}
+}
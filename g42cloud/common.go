@@ -1,11 +1,19 @@
 package g42cloud
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/bss/v2/orders"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
 )
 
@@ -58,22 +66,134 @@ func checkForRetryableError(err error) *resource.RetryError {
 	}
 }
 
+// withCustomizeDiff appends the CustomizeDiffFuncs to the existing CustomizeDiff of the resource.
+func withCustomizeDiff(r *schema.Resource, funcs ...schema.CustomizeDiffFunc) *schema.Resource {
+	if r.CustomizeDiff != nil {
+		funcs = append([]schema.CustomizeDiffFunc{r.CustomizeDiff}, funcs...)
+	}
+	r.CustomizeDiff = customdiff.All(funcs...)
+	return r
+}
+
 func hasFilledOpt(d *schema.ResourceData, param string) bool {
 	_, b := d.GetOk(param)
 	return b
 }
 
-// UnsubscribePrePaidResource impl the action of unsubscribe resource
+// UnsubscribePrePaidResource impl the action of unsubscribe resource, and waits for the unsubscribe orders
+// to be completed
 func UnsubscribePrePaidResource(d *schema.ResourceData, config *config.Config, resourceIDs []string) error {
 	bssV2Client, err := config.BssV2Client(GetRegion(d, config))
 	if err != nil {
 		return fmtp.Errorf("Error creating HuaweiCloud bss V2 client: %s", err)
 	}
 
+	return unsubscribePrePaidResources(bssV2Client, resourceIDs, d.Timeout(schema.TimeoutDelete))
+}
+
+func unsubscribePrePaidResources(bssV2Client *golangsdk.ServiceClient, resourceIDs []string,
+	timeout time.Duration) error {
 	unsubscribeOpts := orders.UnsubscribeOpts{
 		ResourceIds:     resourceIDs,
 		UnsubscribeType: 1,
 	}
-	_, err = orders.Unsubscribe(bssV2Client, unsubscribeOpts).Extract()
-	return err
+	order, err := orders.Unsubscribe(bssV2Client, unsubscribeOpts).Extract()
+	if err != nil {
+		return err
+	}
+
+	return waitForOrdersComplete(context.Background(), bssV2Client, order.OrderIDs, timeout)
+}
+
+// waitForOrdersComplete waits for all of the BSS orders to be completed.
+func waitForOrdersComplete(ctx context.Context, bssV2Client *golangsdk.ServiceClient, orderIDs []string,
+	timeout time.Duration) error {
+	for _, orderID := range orderIDs {
+		log.Printf("[DEBUG] waiting for the order (%s) to be completed", orderID)
+		if err := common.WaitOrderComplete(ctx, bssV2Client, orderID, timeout); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updatePrePaidChargeInfo updates the auto-renew setting of a prePaid resource, and renews it for the new
+// period if `period_unit` or `period` is changed.
+func updatePrePaidChargeInfo(ctx context.Context, d *schema.ResourceData, config *config.Config,
+	resourceID string) error {
+	if d.Get("charging_mode").(string) != "prePaid" {
+		return nil
+	}
+
+	if d.HasChange("auto_renew") {
+		bssV2Client, err := config.BssV2Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("error creating G42Cloud BSS v2 client: %s", err)
+		}
+
+		autoRenew := d.Get("auto_renew").(string)
+		if autoRenew == "" {
+			autoRenew = "false"
+		}
+		if err := common.UpdateAutoRenew(bssV2Client, autoRenew, resourceID); err != nil {
+			return fmt.Errorf("error updating the auto-renew of the resource (%s): %s", resourceID, err)
+		}
+	}
+
+	if d.HasChanges("period_unit", "period") {
+		return renewPrePaidPeriod(ctx, d, config, resourceID)
+	}
+	return nil
+}
+
+// renewPrePaidPeriod renews the prePaid resource for the period specified by `period_unit` and `period`.
+func renewPrePaidPeriod(ctx context.Context, d *schema.ResourceData, config *config.Config, resourceID string) error {
+	if err := validatePrePaidChargeInfo(d); err != nil {
+		return err
+	}
+
+	bssV2Client, err := config.BssV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud BSS v2 client: %s", err)
+	}
+
+	err = renewPrePaidResource(ctx, bssV2Client, resourceID, d.Get("period_unit").(string), d.Get("period").(int),
+		d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error renewing the resource (%s): %s", resourceID, err)
+	}
+	return nil
+}
+
+// renewPrePaidResource renews the prePaid resource for the period and waits for the renewal orders to be completed.
+func renewPrePaidResource(ctx context.Context, bssV2Client *golangsdk.ServiceClient, resourceID, periodUnit string,
+	period int, timeout time.Duration) error {
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"resource_ids": []string{resourceID},
+			"period_type":  periodTypeMap[periodUnit],
+			"period_num":   period,
+			// 0: enter the grace period after the renewed period expires
+			"expire_policy": 0,
+			"is_auto_pay":   1,
+		},
+		OkCodes: []int{200},
+	}
+	log.Printf("[DEBUG] Renew options of the resource (%s): %#v", resourceID, opts.JSONBody)
+	resp, err := bssV2Client.Request("POST", bssV2Client.ResourceBase+"orders/subscriptions/resources/renew", &opts)
+	if err != nil {
+		return err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	orderIDs := utils.ExpandToStringList(
+		utils.PathSearch("order_ids", respBody, make([]interface{}, 0)).([]interface{}))
+	if len(orderIDs) == 0 {
+		return fmt.Errorf("the order ID is not found in the response")
+	}
+	return waitForOrdersComplete(ctx, bssV2Client, orderIDs, timeout)
 }
@@ -0,0 +1,149 @@
+package g42cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+)
+
+// fakeBssServer simulates the BSS order APIs, each query of an order returns the next status in the list and the
+// last status is kept.
+type fakeBssServer struct {
+	mutex        sync.Mutex
+	statuses     map[string][]int
+	unsubscribed []string
+	renewBody    map[string]interface{}
+}
+
+func (s *fakeBssServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/orders/subscriptions/resources/unsubscribe"):
+		var body struct {
+			ResourceIDs []string `json:"resource_ids"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		s.unsubscribed = append(s.unsubscribed, body.ResourceIDs...)
+		fmt.Fprint(w, `{"order_ids": ["order-unsubscribe"]}`)
+	case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/orders/subscriptions/resources/renew"):
+		if err := json.NewDecoder(r.Body).Decode(&s.renewBody); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprint(w, `{"order_ids": ["order-renew"]}`)
+	case r.Method == "GET" && strings.Contains(r.URL.Path, "/orders/customer-orders/details/"):
+		orderID := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		statuses, ok := s.statuses[orderID]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error_code": "CBC.0101", "error_msg": "the order does not exist"}`)
+			return
+		}
+		if len(statuses) > 1 {
+			s.statuses[orderID] = statuses[1:]
+		}
+		fmt.Fprintf(w, `{"order_info": {"status": %d}}`, statuses[0])
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newFakeBssClient(t *testing.T, statuses map[string][]int) (*golangsdk.ServiceClient, *fakeBssServer) {
+	fake := &fakeBssServer{statuses: statuses}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{},
+		Endpoint:       server.URL + "/",
+		ResourceBase:   server.URL + "/v2/",
+	}
+	return client, fake
+}
+
+func TestWaitForOrdersComplete(t *testing.T) {
+	cases := []struct {
+		name      string
+		statuses  map[string][]int
+		orderIDs  []string
+		expectErr bool
+	}{
+		{
+			name:     "no orders",
+			orderIDs: nil,
+		},
+		{
+			name:     "completed",
+			statuses: map[string][]int{"order-1": {5}},
+			orderIDs: []string{"order-1"},
+		},
+		{
+			name:      "cancelled",
+			statuses:  map[string][]int{"order-1": {4}},
+			orderIDs:  []string{"order-1"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		client, _ := newFakeBssClient(t, tc.statuses)
+		err := waitForOrdersComplete(context.Background(), client, tc.orderIDs, time.Minute)
+		if tc.expectErr && err == nil {
+			t.Errorf("%s: expected an error, but got nil", tc.name)
+		}
+		if !tc.expectErr && err != nil {
+			t.Errorf("%s: unexpected error: %s", tc.name, err)
+		}
+	}
+}
+
+func TestWaitForOrdersCompleteTimeout(t *testing.T) {
+	client, _ := newFakeBssClient(t, map[string][]int{"order-1": {6}})
+	err := waitForOrdersComplete(context.Background(), client, []string{"order-1"}, 100*time.Millisecond)
+	if err == nil {
+		t.Fatalf("expected a timeout error when the order is pending payment")
+	}
+}
+
+func TestUnsubscribePrePaidResources(t *testing.T) {
+	client, fake := newFakeBssClient(t, map[string][]int{"order-unsubscribe": {5}})
+	if err := unsubscribePrePaidResources(client, []string{"resource-1"}, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(fake.unsubscribed) != 1 || fake.unsubscribed[0] != "resource-1" {
+		t.Fatalf("expected resource-1 to be unsubscribed, but got %v", fake.unsubscribed)
+	}
+}
+
+func TestRenewPrePaidResource(t *testing.T) {
+	client, fake := newFakeBssClient(t, map[string][]int{"order-renew": {5}})
+	if err := renewPrePaidResource(context.Background(), client, "resource-1", "year", 2, time.Minute); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"resource_ids":  []interface{}{"resource-1"},
+		"period_type":   float64(3),
+		"period_num":    float64(2),
+		"expire_policy": float64(0),
+		"is_auto_pay":   float64(1),
+	}
+	if !reflect.DeepEqual(fake.renewBody, expected) {
+		t.Fatalf("the renew request body is %v, expected %v", fake.renewBody, expected)
+	}
+}
@@ -11,9 +11,17 @@ package g42cloud
 // understandable network information within the instance resource.
 
 import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/compute/v2/servers"
 	"github.com/chnsz/golangsdk/openstack/ecs/v1/cloudservers"
 	"github.com/chnsz/golangsdk/openstack/networking/v1/ports"
+	"github.com/chnsz/golangsdk/openstack/networking/v1/subnets"
+	portsv2 "github.com/chnsz/golangsdk/openstack/networking/v2/ports"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
@@ -231,3 +239,350 @@ func getInstanceAccessAddresses(
 
 	return hostv4, hostv6
 }
+
+// instanceNicChange records the fields of a NIC which are updated on the port rather than by the ECS API. The
+// changes are collected before calling the upstream functions, as the network blocks are overwritten by the read.
+type instanceNicChange struct {
+	Index            int
+	UpdateIPv6       bool
+	IPv6Enable       bool
+	FixedIPv6        string
+	SecurityGroupIDs []string
+	UpdateSecGroups  bool
+	OldVirtualIPs    []string
+	NewVirtualIPs    []string
+}
+
+// extendInstanceNetworkSchema adds the security groups and virtual IPs to the network block, and makes the IPv6
+// fields of the NIC updatable.
+func extendInstanceNetworkSchema(r *schema.Resource) {
+	nic := r.Schema["network"].Elem.(*schema.Resource)
+	nic.Schema["ipv6_enable"].ForceNew = false
+	nic.Schema["fixed_ip_v6"].ForceNew = false
+	nic.Schema["security_group_ids"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Set:      schema.HashString,
+	}
+	nic.Schema["virtual_ip_ids"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Set:      schema.HashString,
+	}
+}
+
+// validateInstanceNics checks the NIC fields in the configuration. The security groups of the instance are applied
+// to all of the NICs, so they can not be specified together with the security groups of the NICs.
+func validateInstanceNics(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	raw := d.GetRawConfig()
+	if raw.IsNull() || !raw.IsKnown() {
+		return nil
+	}
+	networks := raw.GetAttr("network")
+	if networks.IsNull() || !networks.IsKnown() {
+		return nil
+	}
+
+	instanceSecGroups := !raw.GetAttr("security_group_ids").IsNull() || !raw.GetAttr("security_groups").IsNull()
+	for it := networks.ElementIterator(); it.Next(); {
+		_, nic := it.Element()
+		if instanceSecGroups && !nic.GetAttr("security_group_ids").IsNull() {
+			return fmt.Errorf("the security_group_ids of the network can not be specified together with the " +
+				"security_groups or security_group_ids of the instance")
+		}
+
+		ipv6Enable := nic.GetAttr("ipv6_enable")
+		if !nic.GetAttr("fixed_ip_v6").IsNull() && ipv6Enable.IsKnown() && (ipv6Enable.IsNull() || ipv6Enable.False()) {
+			return fmt.Errorf("the fixed_ip_v6 of the network can only be specified when ipv6_enable is true")
+		}
+	}
+	return nil
+}
+
+// collectInstanceNicChanges returns the changed NIC fields. For a new instance, IPv6 is enabled by the ECS API, so
+// the port is updated only when a fixed IPv6 address is specified.
+func collectInstanceNicChanges(d *schema.ResourceData) []instanceNicChange {
+	networks := d.Get("network").([]interface{})
+	changes := make([]instanceNicChange, 0, len(networks))
+	for i, v := range networks {
+		nic := v.(map[string]interface{})
+		key := func(field string) string {
+			return fmt.Sprintf("network.%d.%s", i, field)
+		}
+
+		change := instanceNicChange{
+			Index:           i,
+			IPv6Enable:      nic["ipv6_enable"].(bool),
+			FixedIPv6:       nic["fixed_ip_v6"].(string),
+			UpdateSecGroups: d.HasChange(key("security_group_ids")),
+		}
+		if d.IsNewResource() {
+			change.UpdateIPv6 = change.IPv6Enable && change.FixedIPv6 != ""
+		} else {
+			change.UpdateIPv6 = d.HasChanges(key("ipv6_enable"), key("fixed_ip_v6"))
+		}
+		if change.UpdateSecGroups {
+			change.SecurityGroupIDs = utils.ExpandToStringListBySet(nic["security_group_ids"].(*schema.Set))
+		}
+		if d.HasChange(key("virtual_ip_ids")) {
+			oldRaw, newRaw := d.GetChange(key("virtual_ip_ids"))
+			oldVips, newVips := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+			change.OldVirtualIPs = utils.ExpandToStringListBySet(oldVips.Difference(newVips))
+			change.NewVirtualIPs = utils.ExpandToStringListBySet(newVips.Difference(oldVips))
+		}
+
+		if change.UpdateIPv6 || change.UpdateSecGroups || len(change.OldVirtualIPs) > 0 ||
+			len(change.NewVirtualIPs) > 0 {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
+
+// getInstanceNicVirtualIPs returns the virtual IP IDs of each NIC in the configuration or state.
+func getInstanceNicVirtualIPs(d *schema.ResourceData) [][]string {
+	networks := d.Get("network").([]interface{})
+	result := make([][]string, len(networks))
+	for i, v := range networks {
+		if vips, ok := v.(map[string]interface{})["virtual_ip_ids"].(*schema.Set); ok {
+			result[i] = utils.ExpandToStringListBySet(vips)
+		}
+	}
+	return result
+}
+
+// updateInstanceNicIPv6 enables, disables or changes the IPv6 address of the port, the IPv4 addresses are kept.
+func updateInstanceNicIPv6(networkingClient, portClient *golangsdk.ServiceClient, portID, subnetID string,
+	change instanceNicChange) error {
+	port, err := portsv2.Get(portClient, portID).Extract()
+	if err != nil {
+		return err
+	}
+
+	fixedIPs := make([]portsv2.IP, 0, len(port.FixedIPs)+1)
+	for _, ip := range port.FixedIPs {
+		if utils.IsIPv4Address(ip.IPAddress) {
+			fixedIPs = append(fixedIPs, ip)
+		}
+	}
+	if change.IPv6Enable {
+		subnet, err := subnets.Get(networkingClient, subnetID).Extract()
+		if err != nil {
+			return fmt.Errorf("error fetching subnet %s: %s", subnetID, err)
+		}
+		if subnet.IPv6SubnetId == "" {
+			return fmt.Errorf("IPv6 is not enabled for subnet %s", subnetID)
+		}
+		fixedIPs = append(fixedIPs, portsv2.IP{
+			SubnetID:  subnet.IPv6SubnetId,
+			IPAddress: change.FixedIPv6,
+		})
+	}
+
+	_, err = portsv2.Update(portClient, portID, portsv2.UpdateOpts{FixedIPs: fixedIPs}).Extract()
+	return err
+}
+
+// updateVirtualIPAddressPairs binds the IP address to or unbinds it from the virtual IP, the IP address is added to
+// or removed from the allowed address pairs of the virtual IP port. The updates of the same virtual IP are serialized,
+// since the pairs are read and written back as a whole.
+func updateVirtualIPAddressPairs(client *golangsdk.ServiceClient, vipID, ipAddress string, bind bool) error {
+	osMutexKV.Lock(vipID)
+	defer osMutexKV.Unlock(vipID)
+
+	vip, err := portsv2.Get(client, vipID).Extract()
+	if err != nil {
+		return err
+	}
+
+	pairs := make([]portsv2.AddressPair, 0, len(vip.AllowedAddressPairs)+1)
+	for _, pair := range vip.AllowedAddressPairs {
+		if pair.IPAddress != ipAddress {
+			pairs = append(pairs, pair)
+		}
+	}
+	if bind {
+		pairs = append(pairs, portsv2.AddressPair{IPAddress: ipAddress})
+	}
+
+	_, err = portsv2.Update(client, vipID, portsv2.UpdateOpts{AllowedAddressPairs: &pairs}).Extract()
+	return err
+}
+
+// updateInstanceNicPorts applies the NIC changes to the ports of the instance.
+func updateInstanceNicPorts(d *schema.ResourceData, config *config.Config, changes []instanceNicChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	region := GetRegion(d, config)
+	networkingClient, err := config.NetworkingV1Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud networking client: %s", err)
+	}
+	portClient, err := config.NetworkingV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud networking v2 client: %s", err)
+	}
+
+	for _, change := range changes {
+		nic := d.Get("network").([]interface{})[change.Index].(map[string]interface{})
+		portID := nic["port"].(string)
+		if portID == "" {
+			log.Printf("[WARN] the port of network %d of instance %s is not found", change.Index, d.Id())
+			continue
+		}
+
+		if change.UpdateIPv6 {
+			log.Printf("[DEBUG] Update IPv6 of port %s: enable: %t, address: %s", portID, change.IPv6Enable,
+				change.FixedIPv6)
+			err := updateInstanceNicIPv6(networkingClient, portClient, portID, nic["uuid"].(string), change)
+			if err != nil {
+				return fmt.Errorf("error updating IPv6 on port(%s) of instance(%s): %s", portID, d.Id(), err)
+			}
+		}
+
+		if change.UpdateSecGroups {
+			log.Printf("[DEBUG] Update security groups of port %s: %v", portID, change.SecurityGroupIDs)
+			opts := portsv2.UpdateOpts{SecurityGroups: &change.SecurityGroupIDs}
+			if _, err := portsv2.Update(portClient, portID, opts).Extract(); err != nil {
+				return fmt.Errorf("error updating security groups on port(%s) of instance(%s): %s", portID, d.Id(),
+					err)
+			}
+		}
+
+		ipAddress := nic["fixed_ip_v4"].(string)
+		for _, vipID := range change.OldVirtualIPs {
+			err := updateVirtualIPAddressPairs(portClient, vipID, ipAddress, false)
+			if _, ok := err.(golangsdk.ErrDefault404); err != nil && !ok {
+				return fmt.Errorf("error unbinding virtual IP %s from port(%s) of instance(%s): %s", vipID, portID,
+					d.Id(), err)
+			}
+		}
+		for _, vipID := range change.NewVirtualIPs {
+			if err := updateVirtualIPAddressPairs(portClient, vipID, ipAddress, true); err != nil {
+				return fmt.Errorf("error binding virtual IP %s to port(%s) of instance(%s): %s", vipID, portID,
+					d.Id(), err)
+			}
+		}
+	}
+	return nil
+}
+
+// flattenInstanceNicPorts sets the security groups and the bound virtual IPs of each NIC, only the virtual IPs in
+// the configuration or state are checked.
+func flattenInstanceNicPorts(d *schema.ResourceData, config *config.Config, virtualIPs [][]string) error {
+	client, err := config.NetworkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud networking v2 client: %s", err)
+	}
+
+	networks := d.Get("network").([]interface{})
+	for i, v := range networks {
+		nic := v.(map[string]interface{})
+		portID := nic["port"].(string)
+		if portID == "" {
+			continue
+		}
+
+		port, err := portsv2.Get(client, portID).Extract()
+		if err != nil {
+			log.Printf("[WARN] failed to fetch port %s of instance %s: %s", portID, d.Id(), err)
+			continue
+		}
+		nic["security_group_ids"] = port.SecurityGroups
+
+		boundVips := make([]string, 0)
+		if i < len(virtualIPs) {
+			for _, vipID := range virtualIPs[i] {
+				vip, err := portsv2.Get(client, vipID).Extract()
+				if err != nil {
+					log.Printf("[WARN] failed to fetch virtual IP %s: %s", vipID, err)
+					continue
+				}
+				for _, pair := range vip.AllowedAddressPairs {
+					if pair.IPAddress == nic["fixed_ip_v4"].(string) {
+						boundVips = append(boundVips, vipID)
+						break
+					}
+				}
+			}
+		}
+		nic["virtual_ip_ids"] = boundVips
+	}
+
+	return d.Set("network", networks)
+}
+
+// withComputeInstanceNetworking extends the network blocks of the instance with the fields which are updated in
+// place on the ports: IPv6, security groups and virtual IPs.
+func withComputeInstanceNetworking(r *schema.Resource) *schema.Resource {
+	extendInstanceNetworkSchema(r)
+
+	create := r.CreateContext
+	read := r.ReadContext
+	update := r.UpdateContext
+	del := r.DeleteContext
+
+	readWithNics := func(ctx context.Context, d *schema.ResourceData, meta interface{},
+		virtualIPs [][]string) diag.Diagnostics {
+		diags := read(ctx, d, meta)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if err := flattenInstanceNicPorts(d, meta.(*config.Config), virtualIPs); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		changes := collectInstanceNicChanges(d)
+		virtualIPs := getInstanceNicVirtualIPs(d)
+		if diags := create(ctx, d, meta); diags.HasError() || d.Id() == "" {
+			return diags
+		}
+
+		if err := updateInstanceNicPorts(d, meta.(*config.Config), changes); err != nil {
+			return diag.FromErr(err)
+		}
+		return readWithNics(ctx, d, meta, virtualIPs)
+	}
+
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return readWithNics(ctx, d, meta, getInstanceNicVirtualIPs(d))
+	}
+
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		changes := collectInstanceNicChanges(d)
+		virtualIPs := getInstanceNicVirtualIPs(d)
+		if diags := update(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+
+		// the security groups of the NICs are updated after the security groups of the instance
+		if err := updateInstanceNicPorts(d, meta.(*config.Config), changes); err != nil {
+			return diag.FromErr(err)
+		}
+		return readWithNics(ctx, d, meta, virtualIPs)
+	}
+
+	r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		// unbind the virtual IPs, otherwise the IP addresses are kept in the allowed address pairs of them
+		changes := make([]instanceNicChange, 0)
+		for i, vips := range getInstanceNicVirtualIPs(d) {
+			if len(vips) > 0 {
+				changes = append(changes, instanceNicChange{Index: i, OldVirtualIPs: vips})
+			}
+		}
+		if err := updateInstanceNicPorts(d, meta.(*config.Config), changes); err != nil {
+			return diag.FromErr(err)
+		}
+		return del(ctx, d, meta)
+	}
+
+	return withCustomizeDiff(r, validateInstanceNics)
+}
@@ -0,0 +1,175 @@
+package g42cloud
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the ports of the public access listeners, the private ports are returned by the API
+const (
+	dmsKafkaPublicPlaintextPort = 9094
+	dmsKafkaPublicSaslSslPort   = 9095
+)
+
+func DataSourceDmsKafkaConnectionInfo() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDmsKafkaConnectionInfoRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ssl_enable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"security_protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sasl_mechanisms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"private_bootstrap_servers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"public_bootstrap_servers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cross_vpc_bootstrap_servers": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildDmsKafkaBootstrapServers appends the port to the addresses without port, and joins them with commas.
+func buildDmsKafkaBootstrapServers(addresses string, port int) string {
+	servers := make([]string, 0)
+	for _, address := range strings.Split(addresses, ",") {
+		address = strings.TrimSpace(address)
+		if address == "" {
+			continue
+		}
+		if !strings.Contains(address, ":") {
+			address = fmt.Sprintf("%s:%d", address, port)
+		}
+		servers = append(servers, address)
+	}
+	return strings.Join(servers, ",")
+}
+
+// buildDmsKafkaCrossVpcBootstrapServers returns the advertised addresses of the cross-VPC access.
+func buildDmsKafkaCrossVpcBootstrapServers(instance interface{}) string {
+	brokers := parseDmsKafkaCrossVpcInfo(instance)
+	servers := make([]string, 0, len(brokers))
+	for _, broker := range brokers {
+		ip := utils.PathSearch("advertised_ip", broker, "").(string)
+		port := utils.PathSearch("port", broker, float64(0)).(float64)
+		if ip != "" && port > 0 {
+			servers = append(servers, fmt.Sprintf("%s:%d", ip, int(port)))
+		}
+	}
+	sort.Strings(servers)
+	return strings.Join(servers, ",")
+}
+
+// downloadDmsKafkaSslCertificate downloads the SSL certificate package used by the clients, which contains the
+// truststore and the CA certificate, and returns the content encoded with base64.
+func downloadDmsKafkaSslCertificate(client *golangsdk.ServiceClient, instanceID string) (string, error) {
+	path := buildDmsKafkaPath(client, "v2/{project_id}/instances/{instance_id}/ssl-certs/download",
+		map[string]string{"instance_id": instanceID})
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		MoreHeaders:      map[string]string{"Accept": "application/octet-stream"},
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", path, &opts)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if len(content) == 0 {
+		return "", fmt.Errorf("the SSL certificate is empty")
+	}
+	return base64.StdEncoding.EncodeToString(content), nil
+}
+
+func dataSourceDmsKafkaConnectionInfoRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	instance, err := getDmsKafkaInstance(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting DMS kafka instance (%s): %s", instanceID, err)
+	}
+
+	sslEnable := utils.PathSearch("ssl_enable", instance, false).(bool)
+	privatePort := int(utils.PathSearch("port", instance, float64(0)).(float64))
+	privateServers := buildDmsKafkaBootstrapServers(utils.PathSearch("connect_address", instance, "").(string),
+		privatePort)
+
+	var publicServers string
+	if utils.PathSearch("enable_publicip", instance, false).(bool) {
+		publicPort := dmsKafkaPublicPlaintextPort
+		if sslEnable {
+			publicPort = dmsKafkaPublicSaslSslPort
+		}
+		publicServers = buildDmsKafkaBootstrapServers(
+			utils.PathSearch("public_connect_address", instance, "").(string), publicPort)
+	}
+
+	var caCertificate string
+	if sslEnable {
+		caCertificate, err = downloadDmsKafkaSslCertificate(client, instanceID)
+		if err != nil {
+			return fmt.Errorf("error downloading the SSL certificate of DMS kafka instance (%s): %s", instanceID, err)
+		}
+	}
+
+	d.SetId(instanceID)
+	d.Set("region", region)
+	d.Set("ssl_enable", sslEnable)
+	d.Set("security_protocol", utils.PathSearch("kafka_security_protocol", instance, nil))
+	d.Set("sasl_mechanisms", utils.PathSearch("sasl_enabled_mechanisms", instance, nil))
+	d.Set("private_bootstrap_servers", privateServers)
+	d.Set("public_bootstrap_servers", publicServers)
+	d.Set("cross_vpc_bootstrap_servers", buildDmsKafkaCrossVpcBootstrapServers(instance))
+	d.Set("ca_certificate", caCertificate)
+
+	return nil
+}
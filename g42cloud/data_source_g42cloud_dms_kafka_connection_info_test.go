@@ -0,0 +1,45 @@
+package g42cloud
+
+import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+)
+
+func TestDownloadDmsKafkaSslCertificate(t *testing.T) {
+	content := []byte{0x50, 0x4b, 0x03, 0x04, 0x00, 0xff}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/project-id/instances/instance-1/ssl-certs/download":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			_, _ = w.Write(content)
+		case "/v2/project-id/instances/instance-2/ssl-certs/download":
+			w.WriteHeader(http.StatusOK)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-id"},
+		Endpoint:       server.URL + "/",
+	}
+	certificate, err := downloadDmsKafkaSslCertificate(client, "instance-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := base64.StdEncoding.EncodeToString(content); certificate != expected {
+		t.Fatalf("expected the certificate %s, but got %s", expected, certificate)
+	}
+
+	if _, err := downloadDmsKafkaSslCertificate(client, "instance-2"); err == nil {
+		t.Fatalf("expected an error when the certificate is empty")
+	}
+	if _, err := downloadDmsKafkaSslCertificate(client, "instance-3"); err == nil {
+		t.Fatalf("expected an error when the request fails")
+	}
+}
@@ -0,0 +1,210 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceDmsKafkaConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDmsKafkaConsumerGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dmsKafkaConsumerGroupSchema(),
+			},
+		},
+	}
+}
+
+func dmsKafkaConsumerGroupSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"coordinator_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"lag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"assignment_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"member_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"partitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"partition": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"lag": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"current_offset": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"log_end_offset": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenDmsKafkaConsumerGroup(group, detail interface{}) map[string]interface{} {
+	members := utils.PathSearch("members", detail, make([]interface{}, 0)).([]interface{})
+	memberList := make([]map[string]interface{}, len(members))
+	for i, v := range members {
+		memberList[i] = map[string]interface{}{
+			"member_id": utils.PathSearch("member_id", v, nil),
+			"client_id": utils.PathSearch("client_id", v, nil),
+			"host":      utils.PathSearch("host", v, nil),
+		}
+	}
+
+	offsets := utils.PathSearch("group_message_offsets", detail, make([]interface{}, 0)).([]interface{})
+	partitions := make([]map[string]interface{}, len(offsets))
+	for i, v := range offsets {
+		partitions[i] = map[string]interface{}{
+			"topic":          utils.PathSearch("topic", v, nil),
+			"partition":      utils.PathSearch("partition", v, nil),
+			"lag":            utils.PathSearch("lag", v, nil),
+			"current_offset": utils.PathSearch("message_current_offset", v, nil),
+			"log_end_offset": utils.PathSearch("message_log_end_offset", v, nil),
+		}
+	}
+
+	return map[string]interface{}{
+		"name":                utils.PathSearch("group_id", group, nil),
+		"description":         utils.PathSearch("group_desc", group, nil),
+		"state":               utils.PathSearch("state", group, nil),
+		"coordinator_id":      utils.PathSearch("coordinator_id", group, nil),
+		"lag":                 utils.PathSearch("lag", group, nil),
+		"assignment_strategy": utils.PathSearch("assignment_strategy", detail, nil),
+		"created_at":          flattenDmsKafkaTimestamp(utils.PathSearch("createdAt", group, float64(0)).(float64)),
+		"members":             memberList,
+		"partitions":          partitions,
+	}
+}
+
+func dataSourceDmsKafkaConsumerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	state := d.Get("state").(string)
+	groups, err := listDmsKafkaConsumerGroups(client, instanceID, name)
+	if err != nil {
+		return fmt.Errorf("error querying DMS kafka consumer groups: %s", err)
+	}
+
+	ids := make([]string, 0, len(groups))
+	result := make([]map[string]interface{}, 0, len(groups))
+	for _, group := range groups {
+		groupID := utils.PathSearch("group_id", group, "").(string)
+		// The groups are filtered by name fuzzily by the API.
+		if name != "" && groupID != name {
+			continue
+		}
+		if state != "" && utils.PathSearch("state", group, "").(string) != state {
+			continue
+		}
+
+		// The members and the offsets of the partitions are only returned by the detail API.
+		detail, err := getDmsKafkaAPI(client, "v2/{project_id}/instances/{instance_id}/management/groups/{group}",
+			map[string]string{"instance_id": instanceID, "group": groupID}, nil)
+		if err != nil {
+			return fmt.Errorf("error getting DMS kafka consumer group (%s): %s", groupID, err)
+		}
+
+		ids = append(ids, groupID)
+		result = append(result, flattenDmsKafkaConsumerGroup(group, utils.PathSearch("group", detail, nil)))
+	}
+	log.Printf("[DEBUG] %d of %d DMS kafka consumer groups are matched", len(result), len(groups))
+
+	d.SetId(hashcode.Strings(append([]string{instanceID}, ids...)))
+	d.Set("region", region)
+	if err := d.Set("groups", result); err != nil {
+		return fmt.Errorf("error saving DMS kafka consumer groups: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceDmsKafkaTopics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDmsKafkaTopicsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"max_partitions": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"remain_partitions": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dmsKafkaTopicSchema(),
+			},
+		},
+	}
+}
+
+func dmsKafkaTopicSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"partitions": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"replicas": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"aging_time": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sync_replication": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"sync_flushing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"policies_only": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configs": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"access_policy": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"owner": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenDmsKafkaTopic(topic, policies interface{}) map[string]interface{} {
+	otherConfigs := utils.PathSearch("topic_other_configs", topic, make([]interface{}, 0)).([]interface{})
+	configs := make([]map[string]interface{}, len(otherConfigs))
+	for i, v := range otherConfigs {
+		configs[i] = map[string]interface{}{
+			"name":  utils.PathSearch("name", v, nil),
+			"value": utils.PathSearch("value", v, nil),
+		}
+	}
+
+	policyList := make([]map[string]interface{}, 0)
+	for _, v := range utils.PathSearch("policies", policies, make([]interface{}, 0)).([]interface{}) {
+		policyList = append(policyList, map[string]interface{}{
+			"user_name":     utils.PathSearch("user_name", v, nil),
+			"access_policy": utils.PathSearch("access_policy", v, nil),
+			"owner":         utils.PathSearch("owner", v, nil),
+		})
+	}
+
+	return map[string]interface{}{
+		"name":             utils.PathSearch("name", topic, nil),
+		"description":      utils.PathSearch("topic_desc", topic, nil),
+		"partitions":       utils.PathSearch("partition", topic, nil),
+		"replicas":         utils.PathSearch("replication", topic, nil),
+		"aging_time":       utils.PathSearch("retention_time", topic, nil),
+		"sync_replication": utils.PathSearch("sync_replication", topic, nil),
+		"sync_flushing":    utils.PathSearch("sync_message_flush", topic, nil),
+		"policies_only":    utils.PathSearch("policiesOnly", topic, nil),
+		"created_at":       flattenDmsKafkaTimestamp(utils.PathSearch("created_at", topic, float64(0)).(float64)),
+		"configs":          configs,
+		"policies":         policyList,
+	}
+}
+
+// listDmsKafkaTopics queries all the topics of the Kafka instance, the topics are filtered by the name fuzzily if it
+// is specified. The response of the last page is also returned, which contains the partition quota of the instance.
+func listDmsKafkaTopics(client *golangsdk.ServiceClient, instanceID, name string) ([]interface{}, interface{},
+	error) {
+	params := map[string]string{"instance_id": instanceID}
+	query := url.Values{}
+	if name != "" {
+		query.Set("name", name)
+	}
+	query.Set("limit", "50")
+
+	topics := make([]interface{}, 0)
+	for offset := 0; ; offset += 50 {
+		query.Set("offset", fmt.Sprint(offset))
+		respBody, err := getDmsKafkaAPI(client, "v2/{project_id}/instances/{instance_id}/topics", params, query)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		pageTopics := utils.PathSearch("topics", respBody, make([]interface{}, 0)).([]interface{})
+		topics = append(topics, pageTopics...)
+		total := int(utils.PathSearch("total", respBody, float64(0)).(float64))
+		if len(pageTopics) < 50 || len(topics) >= total {
+			return topics, respBody, nil
+		}
+	}
+}
+
+// getDmsKafkaTopicPolicies queries the access policies of the topic, nil is returned if the topic has no policies.
+func getDmsKafkaTopicPolicies(client *golangsdk.ServiceClient, instanceID, topic string) (interface{}, error) {
+	policies, err := getDmsKafkaAPI(client, "v1/{project_id}/instances/{instance_id}/topics/{topic}/accesspolicy",
+		map[string]string{"instance_id": instanceID, "topic": topic}, nil)
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return policies, nil
+}
+
+func dataSourceDmsKafkaTopicsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	instance, err := getDmsKafkaInstance(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting DMS kafka instance (%s): %s", instanceID, err)
+	}
+	// The access policies are only available when the SASL of the instance is enabled.
+	sslEnable := utils.PathSearch("ssl_enable", instance, false).(bool)
+
+	name := d.Get("name").(string)
+	topics, respBody, err := listDmsKafkaTopics(client, instanceID, name)
+	if err != nil {
+		return fmt.Errorf("error querying DMS kafka topics: %s", err)
+	}
+
+	ids := make([]string, 0, len(topics))
+	result := make([]map[string]interface{}, 0, len(topics))
+	for _, topic := range topics {
+		topicName := utils.PathSearch("name", topic, "").(string)
+		if name != "" && topicName != name {
+			continue
+		}
+
+		var policies interface{}
+		if sslEnable {
+			policies, err = getDmsKafkaTopicPolicies(client, instanceID, topicName)
+			if err != nil {
+				return fmt.Errorf("error querying the access policies of DMS kafka topic (%s): %s", topicName, err)
+			}
+		}
+
+		ids = append(ids, topicName)
+		result = append(result, flattenDmsKafkaTopic(topic, policies))
+	}
+	log.Printf("[DEBUG] %d of %d DMS kafka topics are matched", len(result), len(topics))
+
+	d.SetId(hashcode.Strings(append([]string{instanceID}, ids...)))
+	d.Set("region", region)
+	d.Set("max_partitions", utils.PathSearch("max_partitions", respBody, nil))
+	d.Set("remain_partitions", utils.PathSearch("remain_partitions", respBody, nil))
+	if err := d.Set("topics", result); err != nil {
+		return fmt.Errorf("error saving DMS kafka topics: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package g42cloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+)
+
+func TestListDmsKafkaTopics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v2/project-id/instances/instance-id/topics" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		topics := ""
+		for i := offset; i < offset+limit && i < 60; i++ {
+			if topics != "" {
+				topics += ","
+			}
+			topics += fmt.Sprintf(`{"name": "topic-%d"}`, i)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"total": 60, "max_partitions": 100, "topics": [%s]}`, topics)
+	}))
+	defer server.Close()
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-id"},
+		Endpoint:       server.URL + "/",
+	}
+	topics, respBody, err := listDmsKafkaTopics(client, "instance-id", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(topics) != 60 {
+		t.Fatalf("expected 60 topics, but got %d", len(topics))
+	}
+	if respBody == nil {
+		t.Fatalf("expected the response body of the last page, but got nil")
+	}
+}
+
+func TestGetDmsKafkaTopicPolicies(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/project-id/instances/instance-id/topics/topic-1/accesspolicy":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprint(w, `{"policies": [{"user_name": "user", "access_policy": "all"}]}`)
+		case "/v1/project-id/instances/instance-id/topics/topic-2/accesspolicy":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-id"},
+		Endpoint:       server.URL + "/",
+	}
+	policies, err := getDmsKafkaTopicPolicies(client, "instance-id", "topic-1")
+	if err != nil || policies == nil {
+		t.Fatalf("expected the policies of topic-1, but got %v, %v", policies, err)
+	}
+	if policies, err = getDmsKafkaTopicPolicies(client, "instance-id", "topic-2"); err != nil || policies != nil {
+		t.Fatalf("expected no policies of topic-2, but got %v, %v", policies, err)
+	}
+	if _, err = getDmsKafkaTopicPolicies(client, "instance-id", "topic-3"); err == nil {
+		t.Fatalf("expected an error when the query fails")
+	}
+}
@@ -0,0 +1,113 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/dms/v2/availablezones"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+)
+
+func DataSourceDmsRocketMQAvailabilityZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDmsRocketMQAvailabilityZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"ipv6_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"codes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_az": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ipv6_enable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDmsRocketMQAvailabilityZonesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	resp, err := availablezones.Get(client)
+	if err != nil {
+		return fmt.Errorf("error querying DMS availability zones: %s", err)
+	}
+
+	ipv6Only := d.Get("ipv6_enable").(bool)
+	codes := make([]string, 0, len(resp.AvailableZones))
+	result := make([]map[string]interface{}, 0, len(resp.AvailableZones))
+	for _, az := range resp.AvailableZones {
+		// the sold out availability zones can not be used to create instances
+		if az.ResourceAvailability != "true" || az.SoldOut {
+			continue
+		}
+		if ipv6Only && !az.Ipv6Enable {
+			continue
+		}
+
+		codes = append(codes, az.Code)
+		result = append(result, map[string]interface{}{
+			"id":          az.ID,
+			"code":        az.Code,
+			"name":        az.Name,
+			"port":        az.Port,
+			"default_az":  az.DefaultAz,
+			"ipv6_enable": az.Ipv6Enable,
+		})
+	}
+	log.Printf("[DEBUG] %d of %d DMS availability zones are available", len(result), len(resp.AvailableZones))
+
+	d.SetId(hashcode.Strings(codes))
+	d.Set("region", region)
+	d.Set("codes", codes)
+	if err := d.Set("availability_zones", result); err != nil {
+		return fmt.Errorf("error saving DMS RocketMQ availability zones: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the charging modes of the DMS flavors are hourly and monthly
+var dmsFlavorChargingModes = map[string]string{
+	"postPaid": "hourly",
+	"prePaid":  "monthly",
+}
+
+func DataSourceDmsRocketMQFlavors() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDmsRocketMQFlavorsRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"flavor_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"single", "cluster"}, false),
+			},
+			"arch_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"charging_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"prePaid", "postPaid"}, false),
+			},
+			"storage_spec_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"flavors": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     dmsRocketMQFlavorSchema(),
+			},
+		},
+	}
+}
+
+func dmsRocketMQFlavorSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vm_specification": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arch_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"charging_modes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ios": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"storage_spec_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"unavailability_zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_broker": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"min_broker": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_storage_per_node": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"min_storage_per_node": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_topic_per_broker": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_consumer_per_broker": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_tps_per_broker": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"flavor_alias": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenDmsRocketMQFlavorIOs returns the IOs which match the storage spec code and are available in all of the
+// availability zones.
+func flattenDmsRocketMQFlavorIOs(d *schema.ResourceData, flavor interface{}) []map[string]interface{} {
+	specCode := d.Get("storage_spec_code").(string)
+	azs := utils.ExpandToStringList(d.Get("availability_zones").([]interface{}))
+
+	result := make([]map[string]interface{}, 0)
+	for _, io := range utils.PathSearch("ios", flavor, make([]interface{}, 0)).([]interface{}) {
+		ioSpec := utils.PathSearch("io_spec", io, "").(string)
+		availableZones := utils.ExpandToStringList(
+			utils.PathSearch("available_zones", io, make([]interface{}, 0)).([]interface{}))
+		if (specCode != "" && ioSpec != specCode) || !utils.StrSliceContainsAnother(availableZones, azs) {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"storage_spec_code":    ioSpec,
+			"type":                 utils.PathSearch("type", io, nil),
+			"availability_zones":   availableZones,
+			"unavailability_zones": utils.PathSearch("unavailable_zones", io, nil),
+		})
+	}
+	return result
+}
+
+func flattenDmsRocketMQFlavorProperties(flavor interface{}) []map[string]interface{} {
+	properties := utils.PathSearch("properties", flavor, nil)
+	if properties == nil {
+		return nil
+	}
+
+	property := func(key string) int {
+		return parseDmsFlavorProperty(utils.PathSearch(key, properties, "").(string))
+	}
+	return []map[string]interface{}{
+		{
+			"max_broker":              property("max_broker"),
+			"min_broker":              property("min_broker"),
+			"max_storage_per_node":    property("max_storage_per_node"),
+			"min_storage_per_node":    property("min_storage_per_node"),
+			"max_topic_per_broker":    property("max_topic_per_broker"),
+			"max_consumer_per_broker": property("max_consumer_per_broker"),
+			"max_tps_per_broker":      property("max_tps_per_broker"),
+			"flavor_alias":            utils.PathSearch("product_alias", properties, nil),
+		},
+	}
+}
+
+func flattenDmsFlavorChargingModes(flavor interface{}) []string {
+	modes := utils.PathSearch("charging_mode", flavor, make([]interface{}, 0)).([]interface{})
+	result := make([]string, len(modes))
+	for i, mode := range modes {
+		result[i] = mode.(string)
+		for k, v := range dmsFlavorChargingModes {
+			if v == mode {
+				result[i] = k
+			}
+		}
+	}
+	return result
+}
+
+func dataSourceDmsRocketMQFlavorsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v2/reliability/products"
+	if v, ok := d.GetOk("flavor_id"); ok {
+		listPath += "?product_id=" + url.QueryEscape(v.(string))
+	}
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return fmt.Errorf("error querying DMS RocketMQ flavors: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	flavorType := d.Get("type").(string)
+	archType := d.Get("arch_type").(string)
+	chargingMode := dmsFlavorChargingModes[d.Get("charging_mode").(string)]
+	flavors := utils.PathSearch("products", respBody, make([]interface{}, 0)).([]interface{})
+	ids := make([]string, 0, len(flavors))
+	result := make([]map[string]interface{}, 0, len(flavors))
+	for _, flavor := range flavors {
+		if flavorType != "" && utils.PathSearch("type", flavor, "").(string) != flavorType {
+			continue
+		}
+		archTypes := utils.ExpandToStringList(
+			utils.PathSearch("arch_types", flavor, make([]interface{}, 0)).([]interface{}))
+		if archType != "" && !utils.StrSliceContains(archTypes, archType) {
+			continue
+		}
+		chargingModes := utils.ExpandToStringList(
+			utils.PathSearch("charging_mode", flavor, make([]interface{}, 0)).([]interface{}))
+		if chargingMode != "" && !utils.StrSliceContains(chargingModes, chargingMode) {
+			continue
+		}
+		ios := flattenDmsRocketMQFlavorIOs(d, flavor)
+		if len(ios) == 0 {
+			continue
+		}
+
+		flavorID := utils.PathSearch("product_id", flavor, "").(string)
+		ids = append(ids, flavorID)
+		result = append(result, map[string]interface{}{
+			"id":               flavorID,
+			"type":             utils.PathSearch("type", flavor, nil),
+			"vm_specification": utils.PathSearch("ecs_flavor_id", flavor, nil),
+			"arch_types":       archTypes,
+			"charging_modes":   flattenDmsFlavorChargingModes(flavor),
+			"ios":              ios,
+			"properties":       flattenDmsRocketMQFlavorProperties(flavor),
+		})
+	}
+	log.Printf("[DEBUG] %d of %d DMS RocketMQ flavors are matched", len(result), len(flavors))
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("region", region)
+	d.Set("versions", utils.PathSearch("versions", respBody, nil))
+	if err := d.Set("flavors", result); err != nil {
+		return fmt.Errorf("error saving DMS RocketMQ flavors: %s", err)
+	}
+	return nil
+}
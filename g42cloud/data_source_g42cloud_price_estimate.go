@@ -0,0 +1,300 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the period types used by the BSS price inquiry API
+var periodTypeMap = map[string]int{
+	"month": 2,
+	"year":  3,
+}
+
+func DataSourcePriceEstimate() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourcePriceEstimateRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"charging_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"prePaid", "postPaid",
+				}, false),
+			},
+			"period_unit": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"period"},
+				ValidateFunc: validation.StringInSlice([]string{
+					"month", "year",
+				}, false),
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"period_unit"},
+				ValidateFunc: validation.IntBetween(1, 9),
+			},
+			"products": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"resource_spec": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"resource_size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"size_measure_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"usage_factor": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Duration",
+						},
+						"usage_value": {
+							Type:     schema.TypeFloat,
+							Optional: true,
+							Default:  1,
+						},
+						"usage_measure_id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  4,
+						},
+						"quantity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"official_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"discounted_price": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"currency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_official_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_discounted_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// priceEstimate is the normalized result of both price inquiry APIs.
+type priceEstimate struct {
+	Currency        string
+	OfficialTotal   float64
+	DiscountedTotal float64
+	// the prices of each product, keyed by the product index
+	OfficialPrices   map[string]float64
+	DiscountedPrices map[string]float64
+}
+
+func dataSourcePriceEstimateRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.NewServiceClient("bssv2", region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud BSS v2 client: %s", err)
+	}
+
+	var estimate *priceEstimate
+	if d.Get("charging_mode").(string) == "prePaid" {
+		if err := validatePrePaidChargeInfo(d); err != nil {
+			return err
+		}
+		estimate, err = queryPeriodResourcesPrice(client, d, region)
+	} else {
+		estimate, err = queryOnDemandResourcesPrice(client, d, region)
+	}
+	if err != nil {
+		return err
+	}
+
+	products := d.Get("products").([]interface{})
+	specs := make([]string, len(products))
+	for i, v := range products {
+		product := v.(map[string]interface{})
+		index := strconv.Itoa(i)
+		product["official_price"] = estimate.OfficialPrices[index]
+		product["discounted_price"] = estimate.DiscountedPrices[index]
+		specs[i] = product["resource_spec"].(string)
+	}
+
+	d.SetId(hashcode.Strings(append(specs, region, d.Get("charging_mode").(string))))
+	d.Set("region", region)
+	d.Set("currency", estimate.Currency)
+	d.Set("total_official_price", estimate.OfficialTotal)
+	d.Set("total_discounted_price", estimate.DiscountedTotal)
+	if err := d.Set("products", products); err != nil {
+		return fmt.Errorf("error saving products of price estimate: %s", err)
+	}
+
+	return nil
+}
+
+func buildPriceEstimateProductInfos(d *schema.ResourceData, region string, isPrePaid bool) []map[string]interface{} {
+	products := d.Get("products").([]interface{})
+	productInfos := make([]map[string]interface{}, len(products))
+	for i, v := range products {
+		product := v.(map[string]interface{})
+		info := map[string]interface{}{
+			"id":                 strconv.Itoa(i),
+			"cloud_service_type": product["service_type"],
+			"resource_type":      product["resource_type"],
+			"resource_spec":      product["resource_spec"],
+			"region":             region,
+			"available_zone":     utils.ValueIngoreEmpty(product["availability_zone"]),
+			"resource_size":      utils.ValueIngoreEmpty(product["resource_size"]),
+			"size_measure_id":    utils.ValueIngoreEmpty(product["size_measure_id"]),
+			"subscription_num":   product["quantity"],
+		}
+
+		if isPrePaid {
+			info["period_type"] = periodTypeMap[d.Get("period_unit").(string)]
+			info["period_num"] = d.Get("period")
+		} else {
+			info["usage_factor"] = product["usage_factor"]
+			info["usage_value"] = product["usage_value"]
+			info["usage_measure_id"] = product["usage_measure_id"]
+		}
+		productInfos[i] = utils.RemoveNil(info)
+	}
+	return productInfos
+}
+
+func doPriceInquiry(client *golangsdk.ServiceClient, path string, body map[string]interface{}) (interface{}, error) {
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         body,
+		OkCodes:          []int{200},
+	}
+
+	log.Printf("[DEBUG] Price inquiry options: %#v", body)
+	resp, err := client.Request("POST", client.ResourceBase+path, &opts)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+// queryPeriodResourcesPrice queries the prices of the yearly/monthly products.
+func queryPeriodResourcesPrice(client *golangsdk.ServiceClient, d *schema.ResourceData,
+	region string) (*priceEstimate, error) {
+	body := map[string]interface{}{
+		"project_id":    client.ProjectID,
+		"product_infos": buildPriceEstimateProductInfos(d, region, true),
+	}
+	respBody, err := doPriceInquiry(client, "bills/ratings/period-resources/subscribe-rate", body)
+	if err != nil {
+		return nil, fmt.Errorf("error querying the prices of yearly/monthly products: %s", err)
+	}
+
+	estimate := &priceEstimate{
+		Currency:      utils.PathSearch("currency", respBody, "").(string),
+		OfficialTotal: utils.PathSearch("official_website_rating_result.official_website_amount", respBody, 0.0).(float64),
+		OfficialPrices: flattenProductPrices(utils.PathSearch(
+			"official_website_rating_result.product_rating_results", respBody, nil), "official_website_amount"),
+	}
+
+	// Several discounts may be applicable, the cheapest one will be used.
+	estimate.DiscountedTotal = estimate.OfficialTotal
+	estimate.DiscountedPrices = estimate.OfficialPrices
+	discounts := utils.PathSearch("optional_discount_rating_results", respBody, make([]interface{}, 0)).([]interface{})
+	for _, discount := range discounts {
+		amount := utils.PathSearch("amount", discount, 0.0).(float64)
+		if amount < estimate.DiscountedTotal {
+			estimate.DiscountedTotal = amount
+			estimate.DiscountedPrices = flattenProductPrices(utils.PathSearch("product_rating_results", discount, nil),
+				"amount")
+		}
+	}
+
+	return estimate, nil
+}
+
+// queryOnDemandResourcesPrice queries the prices of the pay-per-use products.
+func queryOnDemandResourcesPrice(client *golangsdk.ServiceClient, d *schema.ResourceData,
+	region string) (*priceEstimate, error) {
+	body := map[string]interface{}{
+		"project_id":    client.ProjectID,
+		"product_infos": buildPriceEstimateProductInfos(d, region, false),
+	}
+	respBody, err := doPriceInquiry(client, "bills/ratings/on-demand-resources", body)
+	if err != nil {
+		return nil, fmt.Errorf("error querying the prices of pay-per-use products: %s", err)
+	}
+
+	productResults := utils.PathSearch("product_rating_results", respBody, nil)
+	estimate := &priceEstimate{
+		Currency:         utils.PathSearch("currency", respBody, "").(string),
+		OfficialTotal:    utils.PathSearch("official_website_amount", respBody, 0.0).(float64),
+		DiscountedTotal:  utils.PathSearch("amount", respBody, 0.0).(float64),
+		OfficialPrices:   flattenProductPrices(productResults, "official_website_amount"),
+		DiscountedPrices: flattenProductPrices(productResults, "amount"),
+	}
+	return estimate, nil
+}
+
+func flattenProductPrices(productResults interface{}, amountKey string) map[string]float64 {
+	prices := make(map[string]float64)
+	results, ok := productResults.([]interface{})
+	if !ok {
+		return prices
+	}
+
+	for _, result := range results {
+		id := utils.PathSearch("id", result, "").(string)
+		prices[id] = utils.PathSearch(amountKey, result, 0.0).(float64)
+	}
+	return prices
+}
@@ -0,0 +1,114 @@
+package g42cloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccPriceEstimateDataSource_prePaid(t *testing.T) {
+	dataSourceName := "data.g42cloud_price_estimate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPriceEstimateDataSource_prePaid,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPriceEstimateDataSourceID(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "products.#", "3"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "currency"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_official_price"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_discounted_price"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "products.0.official_price"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPriceEstimateDataSource_postPaid(t *testing.T) {
+	dataSourceName := "data.g42cloud_price_estimate.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPriceEstimateDataSource_postPaid,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPriceEstimateDataSourceID(dataSourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "products.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "total_official_price"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "products.0.discounted_price"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPriceEstimateDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find price estimate data source: %s ", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Price estimate data source ID not set ")
+		}
+
+		return nil
+	}
+}
+
+const testAccPriceEstimateDataSource_prePaid = `
+data "g42cloud_availability_zones" "test" {}
+
+data "g42cloud_price_estimate" "test" {
+  charging_mode = "prePaid"
+  period_unit   = "month"
+  period        = 1
+
+  products {
+    service_type      = "hws.service.type.ec2"
+    resource_type     = "hws.resource.type.vm"
+    resource_spec     = "s6.large.2.linux"
+    availability_zone = data.g42cloud_availability_zones.test.names[0]
+  }
+  products {
+    service_type      = "hws.service.type.ebs"
+    resource_type     = "hws.resource.type.volume"
+    resource_spec     = "SAS"
+    availability_zone = data.g42cloud_availability_zones.test.names[0]
+    resource_size     = 40
+    size_measure_id   = 17
+  }
+  products {
+    service_type    = "hws.service.type.vpc"
+    resource_type   = "hws.resource.type.bandwidth"
+    resource_spec   = "19_bgp"
+    resource_size   = 5
+    size_measure_id = 15
+  }
+}
+`
+
+const testAccPriceEstimateDataSource_postPaid = `
+data "g42cloud_availability_zones" "test" {}
+
+data "g42cloud_price_estimate" "test" {
+  charging_mode = "postPaid"
+
+  products {
+    service_type      = "hws.service.type.ec2"
+    resource_type     = "hws.resource.type.vm"
+    resource_spec     = "s6.large.2.linux"
+    availability_zone = data.g42cloud_availability_zones.test.names[0]
+    usage_value       = 720
+  }
+}
+`
@@ -0,0 +1,187 @@
+package g42cloud
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// serviceQuota is the quota of a resource type, a negative limit means unlimited.
+type serviceQuota struct {
+	Type  string
+	Used  int
+	Limit int
+}
+
+// ecsLimitKeys maps the quota types to the limit and used keys of the ECS limits API.
+var ecsLimitKeys = map[string][]string{
+	"instances":     {"maxTotalInstances", "totalInstancesUsed"},
+	"cores":         {"maxTotalCores", "totalCoresUsed"},
+	"ram":           {"maxTotalRAMSize", "totalRAMUsed"},
+	"server_groups": {"maxServerGroups", "totalServerGroupsUsed"},
+}
+
+func DataSourceQuotas() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceQuotasRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ecs", "vpc", "rds",
+				}, false),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"quotas": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"used": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"limit": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceQuotasRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	service := d.Get("service").(string)
+
+	allQuotas, err := getServiceQuotas(config, region, service)
+	if err != nil {
+		return err
+	}
+
+	quotaType := d.Get("type").(string)
+	quotas := make([]map[string]interface{}, 0, len(allQuotas))
+	for _, q := range allQuotas {
+		if quotaType != "" && q.Type != quotaType {
+			continue
+		}
+		quotas = append(quotas, map[string]interface{}{
+			"type":  q.Type,
+			"used":  q.Used,
+			"limit": q.Limit,
+		})
+	}
+
+	d.SetId(hashcode.Strings([]string{region, service, quotaType}))
+	d.Set("region", region)
+	if err := d.Set("quotas", quotas); err != nil {
+		return fmt.Errorf("error saving quotas of %s service: %s", service, err)
+	}
+
+	return nil
+}
+
+// getServiceQuotas queries the quotas of the specified service in a region.
+func getServiceQuotas(config *config.Config, region, service string) ([]serviceQuota, error) {
+	switch service {
+	case "ecs":
+		return getEcsQuotas(config, region)
+	case "vpc":
+		return getResourcesQuotas(config, region, "vpc", "v1/{project_id}/quotas")
+	case "rds":
+		return getResourcesQuotas(config, region, "rds", "v3/{project_id}/quotas")
+	default:
+		return nil, fmt.Errorf("the quotas of %s service are not supported", service)
+	}
+}
+
+func getQuotaResponse(client *golangsdk.ServiceClient, httpUrl string) (interface{}, error) {
+	path := client.Endpoint + strings.ReplaceAll(httpUrl, "{project_id}", client.ProjectID)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", path, &opts)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func getEcsQuotas(config *config.Config, region string) ([]serviceQuota, error) {
+	client, err := config.NewServiceClient("ecs", region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating G42Cloud ECS client: %s", err)
+	}
+
+	respBody, err := getQuotaResponse(client, "v1/{project_id}/cloudservers/limits")
+	if err != nil {
+		return nil, fmt.Errorf("error querying ECS quotas: %s", err)
+	}
+
+	quotaTypes := make([]string, 0, len(ecsLimitKeys))
+	for quotaType := range ecsLimitKeys {
+		quotaTypes = append(quotaTypes, quotaType)
+	}
+	sort.Strings(quotaTypes)
+
+	quotas := make([]serviceQuota, 0, len(quotaTypes))
+	for _, quotaType := range quotaTypes {
+		keys := ecsLimitKeys[quotaType]
+		quotas = append(quotas, serviceQuota{
+			Type:  quotaType,
+			Limit: int(utils.PathSearch("absolute."+keys[0], respBody, float64(-1)).(float64)),
+			Used:  int(utils.PathSearch("absolute."+keys[1], respBody, float64(0)).(float64)),
+		})
+	}
+	return quotas, nil
+}
+
+// getResourcesQuotas queries the quotas of the services which return them as `quotas.resources`.
+func getResourcesQuotas(config *config.Config, region, service, httpUrl string) ([]serviceQuota, error) {
+	client, err := config.NewServiceClient(service, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating G42Cloud %s client: %s", strings.ToUpper(service), err)
+	}
+
+	respBody, err := getQuotaResponse(client, httpUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error querying %s quotas: %s", strings.ToUpper(service), err)
+	}
+
+	resources := utils.PathSearch("quotas.resources", respBody, make([]interface{}, 0)).([]interface{})
+	quotas := make([]serviceQuota, len(resources))
+	for i, res := range resources {
+		quotas[i] = serviceQuota{
+			Type:  utils.PathSearch("type", res, "").(string),
+			Limit: int(utils.PathSearch("quota", res, float64(-1)).(float64)),
+			Used:  int(utils.PathSearch("used", res, float64(0)).(float64)),
+		}
+	}
+	return quotas, nil
+}
@@ -0,0 +1,66 @@
+package g42cloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccQuotasDataSource_basic(t *testing.T) {
+	ecsDataSource := "data.g42cloud_quotas.ecs"
+	vpcDataSource := "data.g42cloud_quotas.vpc"
+	rdsDataSource := "data.g42cloud_quotas.rds"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQuotasDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQuotasDataSourceID(ecsDataSource),
+					resource.TestCheckResourceAttr(ecsDataSource, "quotas.#", "1"),
+					resource.TestCheckResourceAttr(ecsDataSource, "quotas.0.type", "instances"),
+					resource.TestCheckResourceAttrSet(ecsDataSource, "quotas.0.limit"),
+					testAccCheckQuotasDataSourceID(vpcDataSource),
+					resource.TestCheckResourceAttr(vpcDataSource, "quotas.0.type", "publicIp"),
+					testAccCheckQuotasDataSourceID(rdsDataSource),
+					resource.TestCheckResourceAttrSet(rdsDataSource, "quotas.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQuotasDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find quotas data source: %s ", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Quotas data source ID not set ")
+		}
+
+		return nil
+	}
+}
+
+const testAccQuotasDataSource_basic = `
+data "g42cloud_quotas" "ecs" {
+  service = "ecs"
+  type    = "instances"
+}
+
+data "g42cloud_quotas" "vpc" {
+  service = "vpc"
+  type    = "publicIp"
+}
+
+data "g42cloud_quotas" "rds" {
+  service = "rds"
+}
+`
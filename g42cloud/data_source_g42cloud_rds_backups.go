@@ -0,0 +1,47 @@
+package g42cloud
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/rds"
+)
+
+// DataSourceRdsBackups extends the RDS backups data source with the filter of the backup status, which is not
+// supported by the list API.
+func DataSourceRdsBackups() *schema.Resource {
+	r := rds.DataSourceBackup()
+	r.Schema["status"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		ValidateFunc: validation.StringInSlice([]string{
+			"BUILDING", "COMPLETED", "FAILED", "DELETING",
+		}, false),
+	}
+
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := read(ctx, d, meta)
+		status, ok := d.GetOk("status")
+		if diags.HasError() || !ok {
+			return diags
+		}
+
+		allBackups := d.Get("backups").([]interface{})
+		backups := make([]interface{}, 0, len(allBackups))
+		for _, backup := range allBackups {
+			if backup.(map[string]interface{})["status"] == status {
+				backups = append(backups, backup)
+			}
+		}
+		if err := d.Set("backups", backups); err != nil {
+			return append(diags, diag.Errorf("error saving RDS backups: %s", err)...)
+		}
+		return diags
+	}
+
+	return r
+}
@@ -0,0 +1,313 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/rds/v3/instances"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceRdsInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRdsInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"datastore_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     rdsInstanceSchema(),
+			},
+		},
+	}
+}
+
+func rdsInstanceSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enterprise_project_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"private_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"public_ips": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"db": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"user_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"volume": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// filterRdsInstances filters the instances by the enterprise project and tags which are not supported by the API.
+func filterRdsInstances(d *schema.ResourceData, all []instances.RdsInstanceResponse) []instances.RdsInstanceResponse {
+	epsID := d.Get("enterprise_project_id").(string)
+	tagFilter := d.Get("tags").(map[string]interface{})
+
+	result := make([]instances.RdsInstanceResponse, 0, len(all))
+	for _, instance := range all {
+		if epsID != "" && instance.EnterpriseProjectId != epsID {
+			continue
+		}
+
+		instanceTags := utils.TagsToMap(instance.Tags)
+		matched := true
+		for k, v := range tagFilter {
+			if value, ok := instanceTags[k]; !ok || value != v.(string) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			result = append(result, instance)
+		}
+	}
+	return result
+}
+
+func flattenRdsInstance(instance instances.RdsInstanceResponse) map[string]interface{} {
+	nodes := make([]map[string]interface{}, len(instance.Nodes))
+	for i, v := range instance.Nodes {
+		nodes[i] = map[string]interface{}{
+			"id":                v.Id,
+			"name":              v.Name,
+			"role":              v.Role,
+			"status":            v.Status,
+			"availability_zone": v.AvailabilityZone,
+		}
+	}
+
+	return map[string]interface{}{
+		"id":                    instance.Id,
+		"name":                  instance.Name,
+		"status":                instance.Status,
+		"type":                  instance.Type,
+		"flavor":                instance.FlavorRef,
+		"port":                  instance.Port,
+		"vpc_id":                instance.VpcId,
+		"subnet_id":             instance.SubnetId,
+		"security_group_id":     instance.SecurityGroupId,
+		"enterprise_project_id": instance.EnterpriseProjectId,
+		"private_ips":           instance.PrivateIps,
+		"public_ips":            instance.PublicIps,
+		"db": []map[string]interface{}{
+			{
+				"type":      instance.DataStore.Type,
+				"version":   instance.DataStore.Version,
+				"port":      instance.Port,
+				"user_name": instance.DbUserName,
+			},
+		},
+		"volume": []map[string]interface{}{
+			{
+				"type": instance.Volume.Type,
+				"size": instance.Volume.Size,
+			},
+		},
+		"nodes":   nodes,
+		"tags":    utils.TagsToMap(instance.Tags),
+		"created": instance.Created,
+	}
+}
+
+func dataSourceRdsInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.RdsV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	listOpts := instances.ListOpts{
+		Name:          d.Get("name").(string),
+		DataStoreType: d.Get("datastore_type").(string),
+		VpcId:         d.Get("vpc_id").(string),
+		SubnetId:      d.Get("subnet_id").(string),
+		Limit:         100,
+	}
+	// The list API returns a single page, query the instances by offset until all of them are returned.
+	allInstances := make([]instances.RdsInstanceResponse, 0)
+	for {
+		pages, err := instances.List(client, listOpts).AllPages()
+		if err != nil {
+			return fmt.Errorf("error querying RDS instances: %s", err)
+		}
+		resp, err := instances.ExtractRdsInstances(pages)
+		if err != nil {
+			return fmt.Errorf("error extracting RDS instances: %s", err)
+		}
+		allInstances = append(allInstances, resp.Instances...)
+		if len(resp.Instances) == 0 || len(allInstances) >= resp.TotalCount {
+			break
+		}
+		listOpts.Offset += listOpts.Limit
+	}
+
+	filtered := filterRdsInstances(d, allInstances)
+	log.Printf("[DEBUG] %d of %d RDS instances are matched", len(filtered), len(allInstances))
+
+	ids := make([]string, len(filtered))
+	result := make([]map[string]interface{}, len(filtered))
+	for i, v := range filtered {
+		ids[i] = v.Id
+		result[i] = flattenRdsInstance(v)
+	}
+
+	d.SetId(hashcode.Strings(ids))
+	d.Set("region", region)
+	if err := d.Set("instances", result); err != nil {
+		return fmt.Errorf("error saving RDS instances: %s", err)
+	}
+	return nil
+}
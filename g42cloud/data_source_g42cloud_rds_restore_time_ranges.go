@@ -0,0 +1,103 @@
+package g42cloud
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceRdsRestoreTimeRanges() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceRdsRestoreTimeRangesRead,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"date": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"restore_time_ranges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRdsRestoreTimeRangesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.RdsV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	httpUrl := fmt.Sprintf("v3/{project_id}/instances/%s/restore-time", instanceID)
+	path := client.Endpoint + strings.ReplaceAll(httpUrl, "{project_id}", client.ProjectID)
+	if date, ok := d.GetOk("date"); ok {
+		path += fmt.Sprintf("?date=%s", date)
+	}
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", path, &opts)
+	if err != nil {
+		return fmt.Errorf("error querying the restore time ranges of RDS instance (%s): %s", instanceID, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	timeRanges := utils.PathSearch("restore_time", respBody, make([]interface{}, 0)).([]interface{})
+	restoreTimeRanges := make([]map[string]interface{}, len(timeRanges))
+	for i, v := range timeRanges {
+		restoreTimeRanges[i] = map[string]interface{}{
+			"start_time": formatRdsRestoreTime(utils.PathSearch("start_time", v, float64(0)).(float64)),
+			"end_time":   formatRdsRestoreTime(utils.PathSearch("end_time", v, float64(0)).(float64)),
+		}
+	}
+
+	d.SetId(hashcode.Strings([]string{region, instanceID, d.Get("date").(string)}))
+	d.Set("region", region)
+	if err := d.Set("restore_time_ranges", restoreTimeRanges); err != nil {
+		return fmt.Errorf("error saving the restore time ranges of RDS instance (%s): %s", instanceID, err)
+	}
+
+	return nil
+}
+
+// formatRdsRestoreTime converts the timestamp in milliseconds to the RFC3339 format, which is accepted by the
+// `restore_time` of RDS instance.
+func formatRdsRestoreTime(timestamp float64) string {
+	return time.UnixMilli(int64(timestamp)).UTC().Format(time.RFC3339)
+}
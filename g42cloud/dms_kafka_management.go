@@ -0,0 +1,188 @@
+package g42cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// parseDmsKafkaResourceID splits the ID in the format of <instance_id>/<name>.
+func parseDmsKafkaResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format (%s), must be <instance_id>/<name>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// flattenDmsKafkaTimestamp converts the timestamp in milliseconds to the RFC3339 format, an empty string is returned if
+// the timestamp is not set.
+func flattenDmsKafkaTimestamp(timestamp float64) string {
+	if timestamp <= 0 {
+		return ""
+	}
+	return utils.FormatTimeStampRFC3339(int64(timestamp)/1000, false)
+}
+
+// buildDmsKafkaPath replaces the parameters of the Kafka API path, the values of the parameters are escaped.
+func buildDmsKafkaPath(client *golangsdk.ServiceClient, httpUrl string, params map[string]string) string {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	for k, v := range params {
+		path = strings.ReplaceAll(path, fmt.Sprintf("{%s}", k), url.PathEscape(v))
+	}
+	return path
+}
+
+// requestDmsKafkaAPI sends the request to the API of the Kafka instance, the request is retried if other operations
+// of the instance are in progress.
+func requestDmsKafkaAPI(client *golangsdk.ServiceClient, method, httpUrl string, params map[string]string,
+	body interface{}, timeout time.Duration) (interface{}, error) {
+	path := buildDmsKafkaPath(client, httpUrl, params)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 204},
+	}
+	if body != nil {
+		opts.JSONBody = body
+	}
+
+	var respBody interface{}
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		resp, err := client.Request(method, path, &opts)
+		if err != nil {
+			log.Printf("[DEBUG] error requesting the API of Kafka instance (%s): %s", params["instance_id"], err)
+			return checkForRetryableError(err)
+		}
+		respBody, err = utils.FlattenResponse(resp)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	return respBody, err
+}
+
+// getDmsKafkaAPI queries the API of the Kafka instance, the query parameters are appended to the path.
+func getDmsKafkaAPI(client *golangsdk.ServiceClient, httpUrl string, params map[string]string,
+	query url.Values) (interface{}, error) {
+	path := buildDmsKafkaPath(client, httpUrl, params)
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", path, &opts)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+// listDmsKafkaConsumerGroups queries all the consumer groups of the Kafka instance, the groups are filtered by the
+// name fuzzily if it is specified.
+func listDmsKafkaConsumerGroups(client *golangsdk.ServiceClient, instanceID, name string) ([]interface{}, error) {
+	params := map[string]string{"instance_id": instanceID}
+	query := url.Values{}
+	if name != "" {
+		query.Set("group", name)
+	}
+	query.Set("limit", "50")
+
+	groups := make([]interface{}, 0)
+	for offset := 0; ; offset += 50 {
+		query.Set("offset", fmt.Sprint(offset))
+		respBody, err := getDmsKafkaAPI(client, "v2/{project_id}/instances/{instance_id}/groups", params, query)
+		if err != nil {
+			return nil, err
+		}
+
+		pageGroups := utils.PathSearch("groups", respBody, make([]interface{}, 0)).([]interface{})
+		groups = append(groups, pageGroups...)
+		total := int(utils.PathSearch("total", respBody, float64(0)).(float64))
+		if len(pageGroups) < 50 || len(groups) >= total {
+			return groups, nil
+		}
+	}
+}
+
+// getDmsKafkaConsumerGroup finds the consumer group by name, a 404 error is returned if it is not found.
+func getDmsKafkaConsumerGroup(client *golangsdk.ServiceClient, instanceID, name string) (interface{}, error) {
+	groups, err := listDmsKafkaConsumerGroups(client, instanceID, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if utils.PathSearch("group_id", group, "").(string) == name {
+			return group, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func getDmsKafkaInstance(client *golangsdk.ServiceClient, instanceID string) (interface{}, error) {
+	return getDmsKafkaAPI(client, "v2/{project_id}/instances/{instance_id}",
+		map[string]string{"instance_id": instanceID}, nil)
+}
+
+// parseDmsKafkaCrossVpcInfo parses the cross-VPC information of the instance, which is a JSON string keyed by the
+// listener IPs of the brokers.
+func parseDmsKafkaCrossVpcInfo(instance interface{}) map[string]interface{} {
+	brokers := make(map[string]interface{})
+	crossVpcInfo := utils.PathSearch("cross_vpc_info", instance, "").(string)
+	if crossVpcInfo == "" {
+		return brokers
+	}
+
+	if err := json.Unmarshal([]byte(crossVpcInfo), &brokers); err != nil {
+		log.Printf("[WARN] failed to parse the cross-VPC information of the Kafka instance: %s", err)
+	}
+	return brokers
+}
+
+// kafkaInstanceStatusRefreshFunc returns PENDING until the change of the instance is done, the instance is EXTENDING
+// during the change and it becomes RUNNING after the change is completed.
+func kafkaInstanceStatusRefreshFunc(client *golangsdk.ServiceClient, instanceID string,
+	done func(instance interface{}) bool) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := getDmsKafkaInstance(client, instanceID)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+
+		status := utils.PathSearch("status", instance, "").(string)
+		if status != "RUNNING" {
+			return instance, status, nil
+		}
+		if !done(instance) {
+			return instance, "PENDING", nil
+		}
+		return instance, status, nil
+	}
+}
+
+// waitForDmsKafkaInstanceRunning waits for the instance to be RUNNING after the change is done.
+func waitForDmsKafkaInstanceRunning(ctx context.Context, client *golangsdk.ServiceClient, instanceID string,
+	timeout time.Duration, done func(instance interface{}) bool) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING", "EXTENDING"},
+		Target:       []string{"RUNNING"},
+		Refresh:      kafkaInstanceStatusRefreshFunc(client, instanceID, done),
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
@@ -0,0 +1,101 @@
+package g42cloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk/openstack/dms/v2/products"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// kafkaInstanceScalingKeys are the arguments which are changed by scaling the Kafka instance.
+var kafkaInstanceScalingKeys = []string{"flavor_id", "broker_num", "storage_space"}
+
+// validateKafkaInstanceScaling checks whether the flavor exists and the broker number and the storage space are within
+// the limits of the flavor, the broker number and the storage space cannot be decreased in place.
+func validateKafkaInstanceScaling(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	flavorID := d.Get("flavor_id").(string)
+	if flavorID == "" || !d.NewValueKnown("flavor_id") || !d.NewValueKnown("broker_num") ||
+		!d.NewValueKnown("storage_space") || (d.Id() != "" && !d.HasChanges(kafkaInstanceScalingKeys...)) {
+		return nil
+	}
+
+	brokerNum := d.Get("broker_num").(int)
+	storageSpace := d.Get("storage_space").(int)
+	if d.Id() != "" {
+		oldBroker, _ := d.GetChange("broker_num")
+		oldStorage, _ := d.GetChange("storage_space")
+		if brokerNum < oldBroker.(int) {
+			return fmt.Errorf("the broker number of the Kafka instance cannot be decreased from %d to %d",
+				oldBroker.(int), brokerNum)
+		}
+		if storageSpace < oldStorage.(int) {
+			return fmt.Errorf("the storage space of the Kafka instance cannot be decreased from %d GB to %d GB",
+				oldStorage.(int), storageSpace)
+		}
+		// the new brokers have the same storage space as the existing ones
+		if oldBroker.(int) > 0 {
+			minStorage := oldStorage.(int) / oldBroker.(int) * brokerNum
+			if storageSpace < minStorage {
+				return fmt.Errorf("the storage space of the Kafka instance must be at least %d GB for %d brokers",
+					minStorage, brokerNum)
+			}
+		}
+	}
+
+	cfg := meta.(*config.Config)
+	region := getResourceDiffRegion(d, cfg)
+	allFlavors, err := getFlavorCache(meta).getKafkaFlavors(cfg, region)
+	if err != nil {
+		log.Printf("[WARN] skip validating the DMS kafka flavor %s: %s", flavorID, err)
+		return nil
+	}
+
+	var flavor *products.Product
+	names := make([]string, 0, len(allFlavors))
+	for i := range allFlavors {
+		if allFlavors[i].ProductId == flavorID {
+			flavor = &allFlavors[i]
+			break
+		}
+		names = append(names, allFlavors[i].ProductId)
+	}
+	if flavor == nil {
+		return fmt.Errorf("the DMS kafka flavor %s does not exist in region %s%s", flavorID, region,
+			buildFlavorSuggestions(flavorID, names))
+	}
+
+	minBroker := parseDmsFlavorProperty(flavor.Properties.MinBroker)
+	maxBroker := parseDmsFlavorProperty(flavor.Properties.MaxBroker)
+	if (minBroker > 0 && brokerNum < minBroker) || (maxBroker > 0 && brokerNum > maxBroker) {
+		return fmt.Errorf("the broker number of the DMS kafka flavor %s must be between %d and %d, but got %d",
+			flavorID, minBroker, maxBroker, brokerNum)
+	}
+
+	minStorage := parseDmsFlavorProperty(flavor.Properties.MinStoragePerNode) * brokerNum
+	maxStorage := parseDmsFlavorProperty(flavor.Properties.MaxStoragePerNode) * brokerNum
+	if (minStorage > 0 && storageSpace < minStorage) || (maxStorage > 0 && storageSpace > maxStorage) {
+		return fmt.Errorf("the storage space of the DMS kafka flavor %s with %d brokers must be between %d GB "+
+			"and %d GB, but got %d GB", flavorID, brokerNum, minStorage, maxStorage, storageSpace)
+	}
+	return nil
+}
+
+// parseDmsFlavorProperty converts the numeric property of the DMS flavor, 0 is returned if it is not a number.
+func parseDmsFlavorProperty(v string) int {
+	result, err := strconv.Atoi(v)
+	if err != nil {
+		return 0
+	}
+	return result
+}
+
+// withKafkaInstanceScaling validates the scaling of the Kafka instance created with `flavor_id` during the plan, the
+// changes of `flavor_id`, `broker_num` and `storage_space` are checked against the flavor before they are applied.
+func withKafkaInstanceScaling(r *schema.Resource) *schema.Resource {
+	return withCustomizeDiff(r, validateKafkaInstanceScaling)
+}
@@ -0,0 +1,139 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// dmsRabbitmqSlashEscape is the escape of the slash (/) in the names of the RabbitMQ resources, such as the default
+// vhost "/", which is required by the management APIs and is also used in the resource IDs.
+const dmsRabbitmqSlashEscape = "__F_SLASH__"
+
+func escapeDmsRabbitmqName(name string) string {
+	return strings.ReplaceAll(name, "/", dmsRabbitmqSlashEscape)
+}
+
+func unescapeDmsRabbitmqName(name string) string {
+	return strings.ReplaceAll(name, dmsRabbitmqSlashEscape, "/")
+}
+
+// buildDmsRabbitmqResourceID joins the instance ID and the names of the RabbitMQ resource with slashes, the slashes
+// in the names are escaped.
+func buildDmsRabbitmqResourceID(instanceID string, names ...string) string {
+	parts := make([]string, 0, len(names)+1)
+	parts = append(parts, instanceID)
+	for _, name := range names {
+		parts = append(parts, escapeDmsRabbitmqName(name))
+	}
+	return strings.Join(parts, "/")
+}
+
+// parseDmsRabbitmqResourceID splits the ID built by buildDmsRabbitmqResourceID, the format is described by format
+// and the number of parts is the number of slashes in it plus one.
+func parseDmsRabbitmqResourceID(id, format string) ([]string, error) {
+	count := strings.Count(format, "/") + 1
+	parts := strings.Split(id, "/")
+	if len(parts) != count {
+		return nil, fmt.Errorf("invalid ID format (%s), must be %s", id, format)
+	}
+	for i, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("invalid ID format (%s), must be %s", id, format)
+		}
+		parts[i] = unescapeDmsRabbitmqName(part)
+	}
+	return parts, nil
+}
+
+// buildDmsRabbitmqPath replaces the parameters of the management API path, the values of the parameters are
+// escaped.
+func buildDmsRabbitmqPath(client *golangsdk.ServiceClient, httpUrl string, params map[string]string) string {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	for k, v := range params {
+		path = strings.ReplaceAll(path, fmt.Sprintf("{%s}", k), escapeDmsRabbitmqName(v))
+	}
+	return path
+}
+
+// requestDmsRabbitmqAPI sends the request to the management API of the RabbitMQ instance, the request is retried if
+// other operations of the instance are in progress.
+func requestDmsRabbitmqAPI(client *golangsdk.ServiceClient, method, httpUrl string, params map[string]string,
+	body interface{}, timeout time.Duration) (interface{}, error) {
+	path := buildDmsRabbitmqPath(client, httpUrl, params)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 204},
+	}
+	if body != nil {
+		opts.JSONBody = body
+	}
+
+	var respBody interface{}
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		resp, err := client.Request(method, path, &opts)
+		if err != nil {
+			log.Printf("[DEBUG] error requesting the management API of RabbitMQ instance (%s): %s",
+				params["instance_id"], err)
+			return checkForRetryableError(err)
+		}
+		respBody, err = utils.FlattenResponse(resp)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	return respBody, err
+}
+
+// listDmsRabbitmqItems queries all the items of the paginated management API, such as the vhosts and queues.
+func listDmsRabbitmqItems(client *golangsdk.ServiceClient, httpUrl string, params map[string]string,
+	key string) ([]interface{}, error) {
+	path := buildDmsRabbitmqPath(client, httpUrl, params)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	items := make([]interface{}, 0)
+	for offset := 0; ; offset += 100 {
+		resp, err := client.Request("GET", fmt.Sprintf("%s?offset=%d&limit=100", path, offset), &opts)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		pageItems := utils.PathSearch(key, respBody, make([]interface{}, 0)).([]interface{})
+		items = append(items, pageItems...)
+		total := int(utils.PathSearch("total", respBody, float64(0)).(float64))
+		if len(pageItems) < 100 || len(items) >= total {
+			return items, nil
+		}
+	}
+}
+
+// getDmsRabbitmqItem finds the item whose field equals to the value from the paginated management API, a 404 error
+// is returned if it is not found.
+func getDmsRabbitmqItem(client *golangsdk.ServiceClient, httpUrl string, params map[string]string, key, field,
+	value string) (interface{}, error) {
+	items, err := listDmsRabbitmqItems(client, httpUrl, params, key)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if utils.PathSearch(field, item, "").(string) == value {
+			return item, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
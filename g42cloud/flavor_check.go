@@ -0,0 +1,403 @@
+package g42cloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	dcsflavors "github.com/chnsz/golangsdk/openstack/dcs/v2/flavors"
+	"github.com/chnsz/golangsdk/openstack/dms/v2/products"
+	"github.com/chnsz/golangsdk/openstack/ecs/v1/flavors"
+	rdsflavors "github.com/chnsz/golangsdk/openstack/rds/v3/flavors"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// the max number of flavor names suggested when the flavor is invalid
+const maxFlavorSuggestions = 3
+
+// ecsFlavorAzStatusRegexp matches the status of each AZ in `cond:operation:az`, e.g. "ae-ad-1a(normal)"
+var ecsFlavorAzStatusRegexp = regexp.MustCompile(`([^,\s()]+)\(([^)]+)\)`)
+
+// flavorCache caches the flavors queried during the plan, so that each query is only sent once
+// for a provider instance.
+type flavorCache struct {
+	mutex      sync.Mutex
+	ecsFlavors map[string][]flavors.Flavor
+	rdsFlavors map[string][]rdsflavors.Flavors
+	dcsFlavors map[string][]dcsflavors.Flavor
+	// the Kafka flavors are keyed by region
+	kafkaFlavors map[string][]products.Product
+}
+
+func newFlavorCache() *flavorCache {
+	return &flavorCache{
+		ecsFlavors:   make(map[string][]flavors.Flavor),
+		rdsFlavors:   make(map[string][]rdsflavors.Flavors),
+		dcsFlavors:   make(map[string][]dcsflavors.Flavor),
+		kafkaFlavors: make(map[string][]products.Product),
+	}
+}
+
+// getEcsFlavors queries the flavors like `g42cloud_compute_flavors` does.
+func (c *flavorCache) getEcsFlavors(cfg *config.Config, region string) ([]flavors.Flavor, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if v, ok := c.ecsFlavors[region]; ok {
+		return v, nil
+	}
+
+	client, err := cfg.ComputeV1Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating G42Cloud ECS client: %s", err)
+	}
+	pages, err := flavors.List(client, flavors.ListOpts{}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error querying ECS flavors: %s", err)
+	}
+	allFlavors, err := flavors.ExtractFlavors(pages)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve ECS flavors: %s", err)
+	}
+
+	c.ecsFlavors[region] = allFlavors
+	return allFlavors, nil
+}
+
+// getRdsFlavors queries the flavors like `g42cloud_rds_flavors` does.
+func (c *flavorCache) getRdsFlavors(cfg *config.Config, region, dbType,
+	dbVersion string) ([]rdsflavors.Flavors, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := fmt.Sprintf("%s/%s/%s", region, dbType, dbVersion)
+	if v, ok := c.rdsFlavors[key]; ok {
+		return v, nil
+	}
+
+	client, err := cfg.RdsV3Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+	listOpts := rdsflavors.DbFlavorsOpts{
+		Versionname: dbVersion,
+	}
+	pages, err := rdsflavors.List(client, listOpts, dbType).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error querying RDS flavors: %s", err)
+	}
+	resp, err := rdsflavors.ExtractDbFlavors(pages)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve RDS flavors: %s", err)
+	}
+
+	c.rdsFlavors[key] = resp.Flavorslist
+	return resp.Flavorslist, nil
+}
+
+// getDcsFlavors queries the flavors like `g42cloud_dcs_flavors` does.
+func (c *flavorCache) getDcsFlavors(cfg *config.Config, region, engine,
+	engineVersion string) ([]dcsflavors.Flavor, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	key := fmt.Sprintf("%s/%s/%s", region, engine, engineVersion)
+	if v, ok := c.dcsFlavors[key]; ok {
+		return v, nil
+	}
+
+	client, err := cfg.DcsV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating G42Cloud DCS client: %s", err)
+	}
+	listOpts := dcsflavors.ListOpts{
+		Engine:        engine,
+		EngineVersion: engineVersion,
+	}
+	allFlavors, err := dcsflavors.List(client, listOpts).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error querying DCS flavors: %s", err)
+	}
+
+	c.dcsFlavors[key] = allFlavors
+	return allFlavors, nil
+}
+
+// getKafkaFlavors queries the flavors like `g42cloud_dms_kafka_flavors` does.
+func (c *flavorCache) getKafkaFlavors(cfg *config.Config, region string) ([]products.Product, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if v, ok := c.kafkaFlavors[region]; ok {
+		return v, nil
+	}
+
+	client, err := cfg.DmsV2Client(region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+	resp, err := products.List(client, "kafka", products.ListOpts{})
+	if err != nil {
+		return nil, fmt.Errorf("error querying DMS kafka flavors: %s", err)
+	}
+
+	c.kafkaFlavors[region] = resp.Products
+	return resp.Products, nil
+}
+
+func getFlavorCache(meta interface{}) *flavorCache {
+	if settings := getProviderSettings(meta); settings != nil {
+		return settings.flavors
+	}
+	return newFlavorCache()
+}
+
+func getResourceDiffRegion(d *schema.ResourceDiff, cfg *config.Config) string {
+	if v, ok := d.GetOk("region"); ok {
+		return v.(string)
+	}
+	return cfg.Region
+}
+
+// isEcsFlavorOnSale checks the status of the flavor in the AZ, the region-level status will be
+// checked if the AZ is not specified.
+func isEcsFlavorOnSale(flavor flavors.Flavor, az string) (bool, string) {
+	status := flavor.OsExtraSpecs.OperationStatus
+	if az != "" {
+		for _, match := range ecsFlavorAzStatusRegexp.FindAllStringSubmatch(flavor.OsExtraSpecs.OperationAz, -1) {
+			if match[1] == az {
+				status = match[2]
+				break
+			}
+		}
+	}
+
+	switch status {
+	case "", "normal", "promotion", "obt":
+		return true, status
+	default:
+		return false, status
+	}
+}
+
+// validateEcsFlavor returns a CustomizeDiffFunc which checks whether the ECS flavor exists and is on sale
+// in the availability zone, it's used by the resources creating ECS instances.
+func validateEcsFlavor(flavorKey, azKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		flavorID := d.Get(flavorKey).(string)
+		if flavorID == "" || !d.NewValueKnown(flavorKey) || (d.Id() != "" && !d.HasChange(flavorKey)) {
+			return nil
+		}
+
+		var az string
+		if d.NewValueKnown(azKey) {
+			az = d.Get(azKey).(string)
+		}
+		// the node pool AZ can be random
+		if az == "random" {
+			az = ""
+		}
+
+		cfg := meta.(*config.Config)
+		region := getResourceDiffRegion(d, cfg)
+		allFlavors, err := getFlavorCache(meta).getEcsFlavors(cfg, region)
+		if err != nil {
+			log.Printf("[WARN] skip validating the ECS flavor %s: %s", flavorID, err)
+			return nil
+		}
+
+		names := make([]string, 0, len(allFlavors))
+		for _, flavor := range allFlavors {
+			if flavor.ID != flavorID {
+				if ok, _ := isEcsFlavorOnSale(flavor, az); ok {
+					names = append(names, flavor.ID)
+				}
+				continue
+			}
+
+			if ok, status := isEcsFlavorOnSale(flavor, az); !ok {
+				if az == "" {
+					return fmt.Errorf("the ECS flavor %s is not on sale in region %s, the status is %s",
+						flavorID, region, status)
+				}
+				return fmt.Errorf("the ECS flavor %s is not on sale in availability zone %s, the status is %s",
+					flavorID, az, status)
+			}
+			return nil
+		}
+
+		return fmt.Errorf("the ECS flavor %s does not exist in region %s%s", flavorID, region,
+			buildFlavorSuggestions(flavorID, names))
+	}
+}
+
+// withEcsFlavorCheck appends the ECS flavor validation to the CustomizeDiff of the resource.
+func withEcsFlavorCheck(r *schema.Resource) *schema.Resource {
+	return withCustomizeDiff(r, validateEcsFlavor("flavor_id", "availability_zone"))
+}
+
+// isRdsFlavorOnSale checks whether the RDS flavor is on sale in all of the availability zones, the first
+// availability zone not on sale is returned.
+func isRdsFlavorOnSale(flavor rdsflavors.Flavors, azs []interface{}) (bool, string) {
+	for _, az := range azs {
+		if status := flavor.Azstatus[az.(string)]; status != "normal" {
+			return false, az.(string)
+		}
+	}
+	return true, ""
+}
+
+// validateRdsInstanceFlavor checks whether the RDS flavor exists and is on sale in all of the availability zones.
+func validateRdsInstanceFlavor(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	flavor := d.Get("flavor").(string)
+	if flavor == "" || !d.NewValueKnown("flavor") || !d.NewValueKnown("availability_zone") ||
+		!d.NewValueKnown("db") || (d.Id() != "" && !d.HasChanges("flavor", "availability_zone")) {
+		return nil
+	}
+
+	dbType := d.Get("db.0.type").(string)
+	dbVersion := d.Get("db.0.version").(string)
+	azs := d.Get("availability_zone").([]interface{})
+	cfg := meta.(*config.Config)
+	region := getResourceDiffRegion(d, cfg)
+	allFlavors, err := getFlavorCache(meta).getRdsFlavors(cfg, region, dbType, dbVersion)
+	if err != nil {
+		log.Printf("[WARN] skip validating the RDS flavor %s: %s", flavor, err)
+		return nil
+	}
+
+	names := make([]string, 0, len(allFlavors))
+	for _, f := range allFlavors {
+		if f.Speccode != flavor {
+			// only suggest the flavors which can be used in the requested availability zones
+			if ok, _ := isRdsFlavorOnSale(f, azs); ok {
+				names = append(names, f.Speccode)
+			}
+			continue
+		}
+
+		if ok, az := isRdsFlavorOnSale(f, azs); !ok {
+			return fmt.Errorf("the RDS flavor %s is not on sale in availability zone %s", flavor, az)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("the RDS flavor %s does not exist for %s %s in region %s%s", flavor, dbType, dbVersion,
+		region, buildFlavorSuggestions(flavor, names))
+}
+
+// isDcsFlavorOnSale checks whether the DCS flavor is available in all of the availability zones.
+func isDcsFlavorOnSale(flavor dcsflavors.Flavor, azs []string) (bool, string) {
+	azCodes := make([]string, 0)
+	for _, v := range flavor.AvailableZones {
+		azCodes = append(azCodes, v.AzCodes...)
+	}
+	for _, az := range azs {
+		if !utils.StrSliceContains(azCodes, az) {
+			return false, az
+		}
+	}
+	return true, ""
+}
+
+// validateDcsInstanceFlavor checks whether the DCS flavor exists for the engine and is available in all of the
+// availability zones.
+func validateDcsInstanceFlavor(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	flavor := d.Get("flavor").(string)
+	if flavor == "" || !d.NewValueKnown("flavor") || !d.NewValueKnown("availability_zones") ||
+		(d.Id() != "" && !d.HasChange("flavor")) {
+		return nil
+	}
+
+	engine := d.Get("engine").(string)
+	engineVersion := d.Get("engine_version").(string)
+	azs := utils.ExpandToStringList(d.Get("availability_zones").([]interface{}))
+	cfg := meta.(*config.Config)
+	region := getResourceDiffRegion(d, cfg)
+	allFlavors, err := getFlavorCache(meta).getDcsFlavors(cfg, region, engine, engineVersion)
+	if err != nil {
+		log.Printf("[WARN] skip validating the DCS flavor %s: %s", flavor, err)
+		return nil
+	}
+
+	names := make([]string, 0, len(allFlavors))
+	for _, f := range allFlavors {
+		if f.SpecCode != flavor {
+			if ok, _ := isDcsFlavorOnSale(f, azs); ok {
+				names = append(names, f.SpecCode)
+			}
+			continue
+		}
+
+		if ok, az := isDcsFlavorOnSale(f, azs); !ok {
+			return fmt.Errorf("the DCS flavor %s is not available in availability zone %s", flavor, az)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("the DCS flavor %s does not exist for %s %s in region %s%s", flavor, engine, engineVersion,
+		region, buildFlavorSuggestions(flavor, names))
+}
+
+// withDcsFlavorCheck appends the DCS flavor validation to the CustomizeDiff of the resource.
+func withDcsFlavorCheck(r *schema.Resource) *schema.Resource {
+	return withCustomizeDiff(r, validateDcsInstanceFlavor)
+}
+
+// buildFlavorSuggestions returns the closest flavor names as a hint of the error message.
+func buildFlavorSuggestions(flavor string, names []string) string {
+	if len(names) == 0 {
+		return ""
+	}
+
+	sort.SliceStable(names, func(i, j int) bool {
+		di, dj := levenshteinDistance(flavor, names[i]), levenshteinDistance(flavor, names[j])
+		if di != dj {
+			return di < dj
+		}
+		return names[i] < names[j]
+	})
+	if len(names) > maxFlavorSuggestions {
+		names = names[:maxFlavorSuggestions]
+	}
+	return fmt.Sprintf(", did you mean: %s?", strings.Join(names, ", "))
+}
+
+// levenshteinDistance calculates the minimum number of single-character edits to change a into b.
+func levenshteinDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func minInt(first int, others ...int) int {
+	result := first
+	for _, v := range others {
+		if v < result {
+			result = v
+		}
+	}
+	return result
+}
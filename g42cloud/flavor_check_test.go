@@ -0,0 +1,150 @@
+package g42cloud
+
+import (
+	"testing"
+
+	dcsflavors "github.com/chnsz/golangsdk/openstack/dcs/v2/flavors"
+	"github.com/chnsz/golangsdk/openstack/ecs/v1/flavors"
+	rdsflavors "github.com/chnsz/golangsdk/openstack/rds/v3/flavors"
+)
+
+func TestLevenshteinDistance(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"s6.small.1", "s6.small.1", 0},
+		{"s6.small.1", "s6.small.2", 1},
+		{"s6.small.1", "s6.medium.2", 7},
+		{"kitten", "sitting", 3},
+	}
+
+	for _, tc := range cases {
+		if got := levenshteinDistance(tc.a, tc.b); got != tc.expected {
+			t.Errorf("levenshteinDistance(%q, %q) = %d, expected %d", tc.a, tc.b, got, tc.expected)
+		}
+	}
+}
+
+func TestBuildFlavorSuggestions(t *testing.T) {
+	cases := []struct {
+		name     string
+		flavor   string
+		names    []string
+		expected string
+	}{
+		{
+			name:     "no candidates",
+			flavor:   "s6.small.1",
+			names:    nil,
+			expected: "",
+		},
+		{
+			name:     "sorted by distance",
+			flavor:   "s6.small.1",
+			names:    []string{"c6.large.2", "s6.small.2", "s6.medium.2"},
+			expected: ", did you mean: s6.small.2, c6.large.2, s6.medium.2?",
+		},
+		{
+			name:     "sorted by name with the same distance",
+			flavor:   "s6.small.1",
+			names:    []string{"s6.small.4", "s6.small.2", "s6.small.3", "c6.large.2"},
+			expected: ", did you mean: s6.small.2, s6.small.3, s6.small.4?",
+		},
+	}
+
+	for _, tc := range cases {
+		if got := buildFlavorSuggestions(tc.flavor, tc.names); got != tc.expected {
+			t.Errorf("%s: buildFlavorSuggestions() = %q, expected %q", tc.name, got, tc.expected)
+		}
+	}
+}
+
+func TestIsEcsFlavorOnSale(t *testing.T) {
+	buildFlavor := func(status, azStatus string) flavors.Flavor {
+		var flavor flavors.Flavor
+		flavor.OsExtraSpecs.OperationStatus = status
+		flavor.OsExtraSpecs.OperationAz = azStatus
+		return flavor
+	}
+
+	cases := []struct {
+		name           string
+		flavor         flavors.Flavor
+		az             string
+		expected       bool
+		expectedStatus string
+	}{
+		{"region normal", buildFlavor("normal", ""), "", true, "normal"},
+		{"region abandon", buildFlavor("abandon", ""), "", false, "abandon"},
+		{"no status", buildFlavor("", ""), "ae-ad-1a", true, ""},
+		{"AZ sellout", buildFlavor("normal", "ae-ad-1a(sellout),ae-ad-1b(normal)"), "ae-ad-1a", false, "sellout"},
+		{"AZ normal", buildFlavor("abandon", "ae-ad-1a(sellout),ae-ad-1b(normal)"), "ae-ad-1b", true, "normal"},
+		{"AZ not listed", buildFlavor("normal", "ae-ad-1a(sellout)"), "ae-ad-1c", true, "normal"},
+		{"AZ promotion", buildFlavor("normal", "ae-ad-1a(promotion)"), "ae-ad-1a", true, "promotion"},
+	}
+
+	for _, tc := range cases {
+		ok, status := isEcsFlavorOnSale(tc.flavor, tc.az)
+		if ok != tc.expected || status != tc.expectedStatus {
+			t.Errorf("%s: isEcsFlavorOnSale() = (%t, %q), expected (%t, %q)", tc.name, ok, status,
+				tc.expected, tc.expectedStatus)
+		}
+	}
+}
+
+func TestIsRdsFlavorOnSale(t *testing.T) {
+	flavor := rdsflavors.Flavors{
+		Azstatus: map[string]string{
+			"ae-ad-1a": "normal",
+			"ae-ad-1b": "abandon",
+		},
+	}
+
+	cases := []struct {
+		azs        []interface{}
+		expected   bool
+		expectedAz string
+	}{
+		{[]interface{}{"ae-ad-1a"}, true, ""},
+		{[]interface{}{"ae-ad-1a", "ae-ad-1b"}, false, "ae-ad-1b"},
+		{[]interface{}{"ae-ad-1c"}, false, "ae-ad-1c"},
+	}
+
+	for _, tc := range cases {
+		ok, az := isRdsFlavorOnSale(flavor, tc.azs)
+		if ok != tc.expected || az != tc.expectedAz {
+			t.Errorf("isRdsFlavorOnSale(%v) = (%t, %q), expected (%t, %q)", tc.azs, ok, az, tc.expected,
+				tc.expectedAz)
+		}
+	}
+}
+
+func TestIsDcsFlavorOnSale(t *testing.T) {
+	flavor := dcsflavors.Flavor{
+		AvailableZones: []dcsflavors.FlavorAzObject{
+			{Capacity: "1", AzCodes: []string{"ae-ad-1a", "ae-ad-1b"}},
+		},
+	}
+
+	cases := []struct {
+		azs        []string
+		expected   bool
+		expectedAz string
+	}{
+		{[]string{"ae-ad-1a"}, true, ""},
+		{[]string{"ae-ad-1a", "ae-ad-1b"}, true, ""},
+		{[]string{"ae-ad-1a", "ae-ad-1c"}, false, "ae-ad-1c"},
+	}
+
+	for _, tc := range cases {
+		ok, az := isDcsFlavorOnSale(flavor, tc.azs)
+		if ok != tc.expected || az != tc.expectedAz {
+			t.Errorf("isDcsFlavorOnSale(%v) = (%t, %q), expected (%t, %q)", tc.azs, ok, az, tc.expected,
+				tc.expectedAz)
+		}
+	}
+}
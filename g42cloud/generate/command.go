@@ -0,0 +1,124 @@
+package generate
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+const usage = `Usage: terraform-provider-g42cloud generate [options]
+
+  Generates the import blocks and the skeleton configuration of the existing resources
+  recorded by RMS. The provider is configured by the G42_* environment variables, e.g.
+  G42_REGION_NAME, G42_ACCESS_KEY and G42_SECRET_KEY.
+
+Options:
+`
+
+// Run parses the arguments of the `generate` command and runs it.
+func Run(args []string, stdout, stderr io.Writer) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprint(stderr, usage)
+		flags.PrintDefaults()
+	}
+
+	regions := flags.String("regions", "",
+		"Comma-separated regions to be queried, defaults to the provider region.")
+	allRegions := flags.Bool("all-regions", false, "Query the resources in all regions.")
+	epsID := flags.String("enterprise-project-id", "",
+		"The enterprise project to be queried, defaults to all enterprise projects.")
+	endpoint := flags.String("endpoint", "", "The custom endpoint of RMS.")
+	configFile := flags.String("out", "", "The file to write the configuration to, defaults to stdout.")
+	unmappedFile := flags.String("unmapped-out", "",
+		"The file to write the resources which cannot be mapped to, defaults to stderr.")
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	cfg, err := configureProvider(*endpoint)
+	if err != nil {
+		return err
+	}
+	client, err := cfg.NewServiceClient("rms", cfg.Region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RMS client: %s", err)
+	}
+	client.DomainID = cfg.DomainID
+
+	generator := &Generator{
+		Client:              client,
+		ResourcesMap:        g42cloud.Provider().ResourcesMap,
+		EnterpriseProjectID: *epsID,
+	}
+	if !*allRegions {
+		generator.Regions = []string{cfg.Region}
+		if *regions != "" {
+			generator.Regions = strings.Split(*regions, ",")
+		}
+	}
+
+	configOut, unmappedOut := stdout, stderr
+	if *configFile != "" {
+		f, err := os.Create(*configFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		configOut = f
+	}
+	if *unmappedFile != "" {
+		f, err := os.Create(*unmappedFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		unmappedOut = f
+	}
+
+	result, err := generator.Run(configOut, unmappedOut)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(stderr, "%d resources are generated, %d resources cannot be mapped\n",
+		len(result.Mapped), len(result.Unmapped))
+	return nil
+}
+
+// configureProvider configures the provider like Terraform does, the arguments are read from the environment.
+func configureProvider(endpoint string) (*config.Config, error) {
+	raw := make(map[string]interface{})
+	if endpoint != "" {
+		raw["endpoints"] = map[string]interface{}{
+			"rms": endpoint,
+		}
+	}
+
+	provider := g42cloud.Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		var messages []string
+		for _, d := range diags {
+			messages = append(messages, d.Summary)
+		}
+		return nil, fmt.Errorf("error configuring G42Cloud provider: %s", strings.Join(messages, "; "))
+	}
+
+	cfg, ok := provider.Meta().(*config.Config)
+	if !ok {
+		return nil, fmt.Errorf("error configuring G42Cloud provider: unexpected meta %T", provider.Meta())
+	}
+	return cfg, nil
+}
@@ -0,0 +1,263 @@
+// Package generate implements the `generate` command, which generates the import blocks and the skeleton
+// configuration of the existing resources recorded by RMS (Resource Management Service).
+package generate
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/rms/v1/resources"
+	"github.com/chnsz/golangsdk/pagination"
+)
+
+// the max page size of the RMS resource-listing API
+const listPageLimit = 200
+
+var invalidNameCharRegexp = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// Generator lists the resources from RMS and maps them onto the resource types of the provider.
+type Generator struct {
+	// Client is the RMS client, its DomainID must be set.
+	Client *golangsdk.ServiceClient
+	// ResourcesMap is the resources registered by the provider, the resource types which are not registered
+	// will be reported as unmapped.
+	ResourcesMap map[string]*schema.Resource
+	// Regions is the regions to be queried, all regions are queried if it's empty.
+	Regions []string
+	// EnterpriseProjectID is the enterprise project to be queried, all enterprise projects are queried if it's empty.
+	EnterpriseProjectID string
+}
+
+// MappedResource is a RMS resource which can be imported as the resource type of the provider.
+type MappedResource struct {
+	Type     string
+	Name     string
+	Resource resources.Resource
+}
+
+// Result is the resources classified by whether they can be mapped.
+type Result struct {
+	Mapped   []MappedResource
+	Unmapped []resources.Resource
+}
+
+// ListResources queries all of the resources recorded by RMS in the regions and the enterprise project.
+func (g *Generator) ListResources() ([]resources.Resource, error) {
+	regions := g.Regions
+	if len(regions) == 0 {
+		regions = []string{""}
+	}
+
+	var allResources []resources.Resource
+	for _, region := range regions {
+		listOpts := resources.ListOpts{
+			Region:              region,
+			EnterpriseProjectId: g.EnterpriseProjectID,
+			Limit:               listPageLimit,
+		}
+		err := resources.List(g.Client, listOpts).EachPage(func(page pagination.Page) (bool, error) {
+			pageResources, err := resources.ExtractResources(page)
+			if err != nil {
+				return false, err
+			}
+			allResources = append(allResources, pageResources...)
+			return true, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error querying RMS resources in region %q: %s", region, err)
+		}
+	}
+	return allResources, nil
+}
+
+// Classify maps the resources onto the resource types of the provider, and generates unique names for them.
+func (g *Generator) Classify(allResources []resources.Resource) *Result {
+	result := new(Result)
+	usedNames := make(map[string]bool)
+
+	sorted := make([]resources.Resource, len(allResources))
+	copy(sorted, allResources)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ti, tj := rmsType(sorted[i]), rmsType(sorted[j])
+		if ti != tj {
+			return ti < tj
+		}
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].Id < sorted[j].Id
+	})
+
+	for _, res := range sorted {
+		resourceType, ok := rmsResourceTypes[rmsType(res)]
+		if !ok || g.ResourcesMap[resourceType] == nil {
+			result.Unmapped = append(result.Unmapped, res)
+			continue
+		}
+
+		name := buildResourceName(res.Name)
+		for i := 2; usedNames[resourceType+"."+name]; i++ {
+			name = fmt.Sprintf("%s_%d", buildResourceName(res.Name), i)
+		}
+		usedNames[resourceType+"."+name] = true
+
+		result.Mapped = append(result.Mapped, MappedResource{
+			Type:     resourceType,
+			Name:     name,
+			Resource: res,
+		})
+	}
+	return result
+}
+
+// Run lists and classifies the resources, then writes the configuration and the unmapped resources.
+func (g *Generator) Run(configOut, unmappedOut io.Writer) (*Result, error) {
+	allResources, err := g.ListResources()
+	if err != nil {
+		return nil, err
+	}
+
+	result := g.Classify(allResources)
+	if err := g.WriteConfig(configOut, result); err != nil {
+		return nil, err
+	}
+	if err := WriteUnmapped(unmappedOut, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// WriteConfig writes an import block and a skeleton resource block for each mapped resource.
+func (g *Generator) WriteConfig(w io.Writer, result *Result) error {
+	var b strings.Builder
+	for i, res := range result.Mapped {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "import {\n  to = %s.%s\n  id = %s\n}\n\n", res.Type, res.Name, hclString(res.Resource.Id))
+		fmt.Fprintf(&b, "resource %q %q {\n", res.Type, res.Name)
+		writeResourceBody(&b, g.ResourcesMap[res.Type], res.Resource)
+		b.WriteString("}\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// WriteUnmapped writes the resources which cannot be mapped as a table.
+func WriteUnmapped(w io.Writer, result *Result) error {
+	if len(result.Unmapped) == 0 {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "TYPE\tID\tNAME\tREGION")
+	for _, res := range result.Unmapped {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", rmsType(res), res.Id, res.Name, res.RegionId)
+	}
+	return tw.Flush()
+}
+
+func writeResourceBody(b *strings.Builder, r *schema.Resource, res resources.Resource) {
+	// the arguments whose values are known from RMS
+	var keys []string
+	values := make(map[string]string)
+	if isArgument(r, "region") && res.RegionId != "" && res.RegionId != "global" {
+		keys = append(keys, "region")
+		values["region"] = hclString(res.RegionId)
+	}
+	if isArgument(r, "name") && res.Name != "" {
+		keys = append(keys, "name")
+		values["name"] = hclString(res.Name)
+	}
+	if isArgument(r, "enterprise_project_id") && res.EpId != "" && res.EpId != "0" {
+		keys = append(keys, "enterprise_project_id")
+		values["enterprise_project_id"] = hclString(res.EpId)
+	}
+
+	width := 0
+	for _, k := range keys {
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+	for _, k := range keys {
+		fmt.Fprintf(b, "  %-*s = %s\n", width, k, values[k])
+	}
+
+	if isArgument(r, "tags") && len(res.Tags) > 0 {
+		tagKeys := make([]string, 0, len(res.Tags))
+		for k := range res.Tags {
+			tagKeys = append(tagKeys, k)
+		}
+		sort.Strings(tagKeys)
+
+		tagWidth := 0
+		for _, k := range tagKeys {
+			if len(hclString(k)) > tagWidth {
+				tagWidth = len(hclString(k))
+			}
+		}
+		b.WriteString("\n  tags = {\n")
+		for _, k := range tagKeys {
+			fmt.Fprintf(b, "    %-*s = %s\n", tagWidth, hclString(k), hclString(res.Tags[k]))
+		}
+		b.WriteString("  }\n")
+	}
+
+	// the required arguments which have to be completed manually
+	var required []string
+	for k, s := range r.Schema {
+		if s.Required && values[k] == "" {
+			required = append(required, k)
+		}
+	}
+	sort.Strings(required)
+	if len(required) > 0 {
+		b.WriteString("\n  # TODO: the following arguments are required\n")
+	}
+	for _, k := range required {
+		if _, ok := r.Schema[k].Elem.(*schema.Resource); ok {
+			fmt.Fprintf(b, "  # %s {}\n", k)
+		} else {
+			fmt.Fprintf(b, "  # %s =\n", k)
+		}
+	}
+}
+
+func isArgument(r *schema.Resource, key string) bool {
+	s, ok := r.Schema[key]
+	return ok && (s.Required || s.Optional)
+}
+
+func rmsType(res resources.Resource) string {
+	return res.Provider + "." + res.Type
+}
+
+// buildResourceName converts the RMS resource name to a valid resource name of the configuration.
+func buildResourceName(name string) string {
+	name = invalidNameCharRegexp.ReplaceAllString(strings.ToLower(name), "_")
+	name = strings.Trim(name, "_-")
+	if name == "" {
+		return "resource"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return "r_" + name
+	}
+	return name
+}
+
+// hclString quotes the string and escapes the template sequences.
+func hclString(s string) string {
+	quoted := strconv.Quote(s)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
@@ -0,0 +1,116 @@
+package generate
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud"
+)
+
+const testDomainID = "test-domain-id"
+
+// newTestRmsServer starts a stand-in RMS server which returns the resources in two pages.
+func newTestRmsServer(t *testing.T) *httptest.Server {
+	pages := map[string]string{
+		"": `{
+			"resources": [
+				{"id": "vpc-id", "name": "vpc-test", "provider": "vpc", "type": "vpcs", "region_id": "ae-ad-1",
+				 "ep_id": "eps-id", "tags": {"owner": "ops", "env": "${prod}"}},
+				{"id": "subnet-id-1", "name": "subnet", "provider": "vpc", "type": "subnets", "region_id": "ae-ad-1",
+				 "ep_id": "0"}
+			],
+			"page_info": {"current_count": 2, "next_marker": "next-page"}
+		}`,
+		"next-page": `{
+			"resources": [
+				{"id": "subnet-id-2", "name": "subnet", "provider": "vpc", "type": "subnets", "region_id": "ae-ad-1"},
+				{"id": "bar-id", "name": "1 bar", "provider": "foo", "type": "bars", "region_id": "ae-ad-1"}
+			],
+			"page_info": {"current_count": 2}
+		}`,
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/resource-manager/domains/"+testDomainID+"/all-resources" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if region := r.URL.Query().Get("region_id"); region != "ae-ad-1" {
+			t.Errorf("unexpected region_id: %s", region)
+		}
+
+		body, ok := pages[r.URL.Query().Get("marker")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+}
+
+func TestGeneratorRun(t *testing.T) {
+	server := newTestRmsServer(t)
+	defer server.Close()
+
+	generator := &Generator{
+		Client: &golangsdk.ServiceClient{
+			ProviderClient: &golangsdk.ProviderClient{DomainID: testDomainID},
+			Endpoint:       server.URL + "/",
+			ResourceBase:   server.URL + "/v1/",
+		},
+		ResourcesMap: g42cloud.Provider().ResourcesMap,
+		Regions:      []string{"ae-ad-1"},
+	}
+
+	var configOut, unmappedOut bytes.Buffer
+	result, err := generator.Run(&configOut, &unmappedOut)
+	if err != nil {
+		t.Fatalf("error running generator: %s", err)
+	}
+	if len(result.Mapped) != 3 || len(result.Unmapped) != 1 {
+		t.Fatalf("expected 3 mapped and 1 unmapped resources, got %d and %d", len(result.Mapped),
+			len(result.Unmapped))
+	}
+
+	config := configOut.String()
+	for _, expected := range []string{
+		"import {\n  to = g42cloud_vpc.vpc-test\n  id = \"vpc-id\"\n}\n",
+		"resource \"g42cloud_vpc\" \"vpc-test\" {\n  region                = \"ae-ad-1\"\n  name                  = \"vpc-test\"\n" +
+			"  enterprise_project_id = \"eps-id\"\n",
+		"    \"env\"   = \"$${prod}\"\n    \"owner\" = \"ops\"\n",
+		"  # cidr =\n",
+		"import {\n  to = g42cloud_vpc_subnet.subnet\n  id = \"subnet-id-1\"\n}\n",
+		"import {\n  to = g42cloud_vpc_subnet.subnet_2\n  id = \"subnet-id-2\"\n}\n",
+		"resource \"g42cloud_vpc_subnet\" \"subnet\" {\n  region = \"ae-ad-1\"\n  name   = \"subnet\"\n\n",
+	} {
+		if !strings.Contains(config, expected) {
+			t.Errorf("expected the configuration to contain %q, got:\n%s", expected, config)
+		}
+	}
+
+	unmapped := unmappedOut.String()
+	if !strings.Contains(unmapped, "foo.bars") || !strings.Contains(unmapped, "bar-id") {
+		t.Errorf("expected foo.bars to be unmapped, got:\n%s", unmapped)
+	}
+}
+
+func TestBuildResourceName(t *testing.T) {
+	cases := map[string]string{
+		"vpc-test":     "vpc-test",
+		"My Server 01": "my_server_01",
+		"1st.volume":   "r_1st_volume",
+		"__":           "resource",
+		"":             "resource",
+	}
+	for name, expected := range cases {
+		if got := buildResourceName(name); got != expected {
+			t.Errorf("buildResourceName(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}
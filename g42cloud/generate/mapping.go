@@ -0,0 +1,40 @@
+package generate
+
+// rmsResourceTypes maps the RMS resource types (provider.type) to the resource types of the provider.
+// Only the resources which can be imported by the RMS resource ID are listed.
+var rmsResourceTypes = map[string]string{
+	"as.scalingConfigs":      "g42cloud_as_configuration",
+	"as.scalingGroups":       "g42cloud_as_group",
+	"cbr.vault":              "g42cloud_cbr_vault",
+	"cce.clusters":           "g42cloud_cce_cluster",
+	"css.clusters":           "g42cloud_css_cluster",
+	"dcs.memcached":          "g42cloud_dcs_instance",
+	"dcs.redis":              "g42cloud_dcs_instance",
+	"dds.instances":          "g42cloud_dds_instance",
+	"dms.kafkas":             "g42cloud_dms_kafka_instance",
+	"dms.rabbitmqs":          "g42cloud_dms_rabbitmq_instance",
+	"dns.privateZones":       "g42cloud_dns_zone",
+	"dns.publicZones":        "g42cloud_dns_zone",
+	"dws.clusters":           "g42cloud_dws_cluster",
+	"ecs.cloudservers":       "g42cloud_compute_instance",
+	"elb.listeners":          "g42cloud_elb_listener",
+	"elb.loadbalancers":      "g42cloud_elb_loadbalancer",
+	"elb.pools":              "g42cloud_elb_pool",
+	"evs.volumes":            "g42cloud_evs_volume",
+	"fgs.functions":          "g42cloud_fgs_function",
+	"iam.groups":             "g42cloud_identity_group",
+	"iam.users":              "g42cloud_identity_user",
+	"ims.images":             "g42cloud_images_image",
+	"kms.keys":               "g42cloud_kms_key",
+	"nat.natGateways":        "g42cloud_nat_gateway",
+	"obs.buckets":            "g42cloud_obs_bucket",
+	"rds.instances":          "g42cloud_rds_instance",
+	"sfs.turbos":             "g42cloud_sfs_turbo",
+	"vpc.bandwidths":         "g42cloud_vpc_bandwidth",
+	"vpc.publicips":          "g42cloud_vpc_eip",
+	"vpc.securityGroups":     "g42cloud_networking_secgroup",
+	"vpc.subnets":            "g42cloud_vpc_subnet",
+	"vpc.vpcs":               "g42cloud_vpc",
+	"vpcep.endpointServices": "g42cloud_vpcep_service",
+	"vpcep.endpoints":        "g42cloud_vpcep_endpoint",
+}
@@ -60,6 +60,24 @@ import (
 // This is a global MutexKV for use within this plugin.
 var osMutexKV = mutexkv.NewMutexKV()
 
+// providerSettings holds the provider-level settings which are not included in config.Config.
+type providerSettings struct {
+	CheckQuotasOnPlan bool
+
+	quotas  *quotaTracker
+	flavors *flavorCache
+}
+
+// getProviderSettings returns the settings of the provider instance, which are stored in the metadata of the config.
+func getProviderSettings(meta interface{}) *providerSettings {
+	if cfg, ok := meta.(*config.Config); ok {
+		if settings, ok := cfg.Metadata.(*providerSettings); ok {
+			return settings
+		}
+	}
+	return nil
+}
+
 func init() {
 	waf.PaidType = "postPaid"
 	ecs.SystemDiskType = "SAS"
@@ -180,6 +198,13 @@ func Provider() *schema.Provider {
 				Description: descriptions["max_retries"],
 				DefaultFunc: schema.EnvDefaultFunc("G42_MAX_RETRIES", 5),
 			},
+
+			"check_quotas_on_plan": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: descriptions["check_quotas_on_plan"],
+				DefaultFunc: schema.EnvDefaultFunc("G42_CHECK_QUOTAS_ON_PLAN", false),
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -202,11 +227,18 @@ func Provider() *schema.Provider {
 			"g42cloud_dcs_maintainwindow":   dcs.DataSourceDcsMaintainWindow(),
 			"g42cloud_dcs_product":          deprecated.DataSourceDcsProductV1(),
 
-			"g42cloud_dms_az":              deprecated.DataSourceDmsAZ(),
-			"g42cloud_dms_product":         dms.DataSourceDmsProduct(),
-			"g42cloud_dms_maintainwindow":  dms.DataSourceDmsMaintainWindow(),
-			"g42cloud_dms_kafka_flavors":   dms.DataSourceKafkaFlavors(),
-			"g42cloud_dms_kafka_instances": dms.DataSourceDmsKafkaInstances(),
+			"g42cloud_dms_az":                          deprecated.DataSourceDmsAZ(),
+			"g42cloud_dms_product":                     dms.DataSourceDmsProduct(),
+			"g42cloud_dms_maintainwindow":              dms.DataSourceDmsMaintainWindow(),
+			"g42cloud_dms_kafka_flavors":               dms.DataSourceKafkaFlavors(),
+			"g42cloud_dms_kafka_instances":             dms.DataSourceDmsKafkaInstances(),
+			"g42cloud_dms_kafka_consumer_groups":       DataSourceDmsKafkaConsumerGroups(),
+			"g42cloud_dms_kafka_connection_info":       DataSourceDmsKafkaConnectionInfo(),
+			"g42cloud_dms_kafka_topics":                DataSourceDmsKafkaTopics(),
+			"g42cloud_dms_rocketmq_availability_zones": DataSourceDmsRocketMQAvailabilityZones(),
+			"g42cloud_dms_rocketmq_broker":             dms.DataSourceDmsRocketMQBroker(),
+			"g42cloud_dms_rocketmq_flavors":            DataSourceDmsRocketMQFlavors(),
+			"g42cloud_dms_rocketmq_instances":          dms.DataSourceDmsRocketMQInstances(),
 
 			"g42cloud_elb_certificate":            elb.DataSourceELBCertificateV3(),
 			"g42cloud_elb_flavors":                elb.DataSourceElbFlavorsV3(),
@@ -223,10 +255,15 @@ func Provider() *schema.Provider {
 			"g42cloud_networking_port":            vpc.DataSourceNetworkingPortV2(),
 			"g42cloud_networking_secgroup":        vpc.DataSourceNetworkingSecGroup(),
 			"g42cloud_obs_bucket_object":          obs.DataSourceObsBucketObject(),
+			"g42cloud_price_estimate":             DataSourcePriceEstimate(),
+			"g42cloud_quotas":                     DataSourceQuotas(),
 
 			"g42cloud_rms_policy_definitions": rms.DataSourcePolicyDefinitions(),
 
-			"g42cloud_rds_flavors": rds.DataSourceRdsFlavor(),
+			"g42cloud_rds_backups":             DataSourceRdsBackups(),
+			"g42cloud_rds_flavors":             rds.DataSourceRdsFlavor(),
+			"g42cloud_rds_instances":           DataSourceRdsInstances(),
+			"g42cloud_rds_restore_time_ranges": DataSourceRdsRestoreTimeRanges(),
 
 			"g42cloud_servicestage_component_runtimes": servicestage.DataSourceComponentRuntimes(),
 
@@ -276,11 +313,11 @@ func Provider() *schema.Provider {
 			"g42cloud_cbr_policy":                cbr.ResourcePolicy(),
 			"g42cloud_cbr_vault":                 cbr.ResourceVault(),
 			"g42cloud_cce_cluster":               cce.ResourceCCEClusterV3(),
-			"g42cloud_cce_node":                  cce.ResourceNode(),
+			"g42cloud_cce_node":                  withQuotaCheck(cce.ResourceNode(), "ecs", "instances"),
 			"g42cloud_cce_addon":                 cce.ResourceAddon(),
-			"g42cloud_cce_node_pool":             cce.ResourceNodePool(),
+			"g42cloud_cce_node_pool":             withEcsFlavorCheck(cce.ResourceNodePool()),
 			"g42cloud_ces_alarmrule":             ces.ResourceAlarmRule(),
-			"g42cloud_compute_instance":          ecs.ResourceComputeInstance(),
+			"g42cloud_compute_instance":          withEcsFlavorCheck(withQuotaCheck(withComputeInstanceNetworking(ecs.ResourceComputeInstance()), "ecs", "instances")),
 			"g42cloud_compute_interface_attach":  ecs.ResourceComputeInterfaceAttach(),
 			"g42cloud_compute_keypair":           huaweicloud.ResourceComputeKeypairV2(),
 			"g42cloud_compute_servergroup":       ecs.ResourceComputeServerGroup(),
@@ -294,11 +331,11 @@ func Provider() *schema.Provider {
 			"g42cloud_cts_data_tracker":          cts.ResourceCTSDataTracker(),
 			"g42cloud_dc_virtual_gateway":        dc.ResourceVirtualGateway(),
 			"g42cloud_dc_virtual_interface":      dc.ResourceVirtualInterface(),
-			"g42cloud_dcs_instance":              dcs.ResourceDcsInstance(),
+			"g42cloud_dcs_instance":              withDcsFlavorCheck(dcs.ResourceDcsInstance()),
 			"g42cloud_dds_instance":              dds.ResourceDdsInstanceV3(),
 			"g42cloud_dli_queue":                 dli.ResourceDliQueue(),
 			"g42cloud_dms_instance":              deprecated.ResourceDmsInstancesV1(),
-			"g42cloud_dms_kafka_instance":        dms.ResourceDmsKafkaInstance(),
+			"g42cloud_dms_kafka_instance":        withKafkaInstanceScaling(dms.ResourceDmsKafkaInstance()),
 			"g42cloud_dms_kafka_topic":           dms.ResourceDmsKafkaTopic(),
 			"g42cloud_dms_kafka_user":            dms.ResourceDmsKafkaUser(),
 			"g42cloud_dms_kafka_permissions":     dms.ResourceDmsKafkaPermissions(),
@@ -344,6 +381,20 @@ func Provider() *schema.Provider {
 			"g42cloud_mapreduce_cluster":         mrs.ResourceMRSClusterV2(),
 			"g42cloud_mapreduce_job":             mrs.ResourceMRSJobV2(),
 
+			"g42cloud_dms_kafka_consumer_group":              ResourceDmsKafkaConsumerGroup(),
+			"g42cloud_dms_kafka_consumer_group_offset_reset": ResourceDmsKafkaConsumerGroupOffsetReset(),
+			"g42cloud_dms_kafka_dump_task":                   ResourceDmsKafkaDumpTask(),
+			"g42cloud_dms_kafka_smart_connect":               ResourceDmsKafkaSmartConnect(),
+			"g42cloud_dms_rabbitmq_exchange":                 ResourceDmsRabbitmqExchange(),
+			"g42cloud_dms_rabbitmq_exchange_binding":         ResourceDmsRabbitmqExchangeBinding(),
+			"g42cloud_dms_rabbitmq_queue":                    ResourceDmsRabbitmqQueue(),
+			"g42cloud_dms_rabbitmq_user":                     ResourceDmsRabbitmqUser(),
+			"g42cloud_dms_rabbitmq_vhost":                    ResourceDmsRabbitmqVhost(),
+			"g42cloud_dms_rocketmq_consumer_group":           dms.ResourceDmsRocketMQConsumerGroup(),
+			"g42cloud_dms_rocketmq_instance":                 dms.ResourceDmsRocketMQInstance(),
+			"g42cloud_dms_rocketmq_topic":                    dms.ResourceDmsRocketMQTopic(),
+			"g42cloud_dms_rocketmq_user":                     dms.ResourceDmsRocketMQUser(),
+
 			"g42cloud_modelarts_dataset":                modelarts.ResourceDataset(),
 			"g42cloud_modelarts_dataset_version":        modelarts.ResourceDatasetVersion(),
 			"g42cloud_modelarts_notebook":               modelarts.ResourceNotebook(),
@@ -363,9 +414,17 @@ func Provider() *schema.Provider {
 			"g42cloud_rms_resource_aggregation_authorization": rms.ResourceAggregationAuthorization(),
 			"g42cloud_rms_resource_recorder":                  rms.ResourceRecorder(),
 
-			"g42cloud_rds_instance":              ResourceRdsInstanceV3(),
-			"g42cloud_rds_parametergroup":        rds.ResourceRdsConfiguration(),
-			"g42cloud_rds_read_replica_instance": rds.ResourceRdsReadReplicaInstance(),
+			"g42cloud_rds_account":                      ResourceRdsAccount(),
+			"g42cloud_rds_backup":                       rds.ResourceBackup(),
+			"g42cloud_rds_cross_region_backup_strategy": rds.ResourceBackupStrategy(),
+			"g42cloud_rds_database":                     ResourceRdsDatabase(),
+			"g42cloud_rds_database_privilege":           ResourceRdsDatabasePrivilege(),
+			"g42cloud_rds_instance":                     withQuotaCheck(ResourceRdsInstanceV3(), "rds", "instance"),
+			"g42cloud_rds_mysql_proxy":                  ResourceRdsMysqlProxy(),
+			"g42cloud_rds_parametergroup":               rds.ResourceRdsConfiguration(),
+			"g42cloud_rds_pg_account_roles":             ResourceRdsPgAccountRoles(),
+			"g42cloud_rds_pg_plugin":                    ResourceRdsPgPlugin(),
+			"g42cloud_rds_read_replica_instance":        rds.ResourceRdsReadReplicaInstance(),
 
 			"g42cloud_servicestage_application":                 servicestage.ResourceApplication(),
 			"g42cloud_servicestage_component_instance":          servicestage.ResourceComponentInstance(),
@@ -384,7 +443,7 @@ func Provider() *schema.Provider {
 			"g42cloud_swr_repository_sharing":                   swr.ResourceSWRRepositorySharing(),
 			"g42cloud_tms_tags":                                 tms.ResourceTmsTag(),
 			"g42cloud_vpc_bandwidth":                            eip.ResourceVpcBandWidthV2(),
-			"g42cloud_vpc_eip":                                  eip.ResourceVpcEIPV1(),
+			"g42cloud_vpc_eip":                                  withQuotaCheck(eip.ResourceVpcEIPV1(), "vpc", "publicIp"),
 			"g42cloud_vpc_eip_associate":                        eip.ResourceEIPAssociate(),
 			"g42cloud_vpc":                                      vpc.ResourceVirtualPrivateCloudV1(),
 			"g42cloud_vpc_route":                                vpc.ResourceVPCRouteTableRoute(),
@@ -405,7 +464,7 @@ func Provider() *schema.Provider {
 			"g42cloud_waf_rule_cc_protection":                   waf.ResourceRuleCCProtection(),
 			"g42cloud_waf_rule_precise_protection":              waf.ResourceRulePreciseProtection(),
 			"g42cloud_networking_eip_associate":                 eip.ResourceEIPAssociate(),
-			"g42cloud_networking_secgroup":                      vpc.ResourceNetworkingSecGroup(),
+			"g42cloud_networking_secgroup":                      withQuotaCheck(vpc.ResourceNetworkingSecGroup(), "vpc", "securityGroup"),
 			"g42cloud_networking_secgroup_rule":                 vpc.ResourceNetworkingSecGroupRule(),
 			"g42cloud_networking_vip":                           vpc.ResourceNetworkingVip(),
 			"g42cloud_networking_vip_associate":                 vpc.ResourceNetworkingVIPAssociateV2(),
@@ -420,6 +479,12 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	// the upstream resources whose charging mode can be changed in place
+	withChargingModeConversion(provider.ResourcesMap["g42cloud_compute_instance"], convertEcsInstanceToPrePaid)
+	withChargingModeConversion(provider.ResourcesMap["g42cloud_vpc_eip"], convertEipToPrePaid)
+	// the EVS volumes are changed to prePaid along with the ECS instances
+	withCustomizeDiff(provider.ResourcesMap["g42cloud_evs_volume"], validateEvsVolumeChargingModeChange)
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -460,6 +525,8 @@ func init() {
 		"account_name": "The name of the Account to login with.",
 
 		"insecure": "Trust self-signed certificates.",
+
+		"check_quotas_on_plan": "Whether to check the remaining quotas when planning to create resources.",
 	}
 }
 
@@ -516,6 +583,12 @@ func configureProvider(d *schema.ResourceData, terraformVersion string) (interfa
 
 	config.Endpoints = endpoints
 
+	config.Metadata = &providerSettings{
+		CheckQuotasOnPlan: d.Get("check_quotas_on_plan").(bool),
+		quotas:            newQuotaTracker(),
+		flavors:           newFlavorCache(),
+	}
+
 	return &config, nil
 }
 
@@ -0,0 +1,140 @@
+package g42cloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// quotaUsage records the quota of a resource type and how many resources are planned to be created.
+type quotaUsage struct {
+	serviceQuota
+	Planned int
+	Warned  bool
+}
+
+// quotaTracker caches the quotas queried during the plan, so that the resources planned to be created
+// by all resources of the same type are checked against the same remaining quota.
+type quotaTracker struct {
+	mutex  sync.Mutex
+	usages map[string]*quotaUsage
+	loaded map[string]bool
+}
+
+func newQuotaTracker() *quotaTracker {
+	return &quotaTracker{
+		usages: make(map[string]*quotaUsage),
+		loaded: make(map[string]bool),
+	}
+}
+
+// reserve records the resources planned to be created and returns an error if they would exceed the quota.
+func (t *quotaTracker) reserve(cfg *config.Config, region, service, quotaType string, count int) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	serviceKey := fmt.Sprintf("%s/%s", region, service)
+	if !t.loaded[serviceKey] {
+		quotas, err := getServiceQuotas(cfg, region, service)
+		if err != nil {
+			// an unavailable quota API should not block the plan
+			log.Printf("[WARN] skip checking the %s quota of %s service: %s", quotaType, service, err)
+			return nil
+		}
+		for _, q := range quotas {
+			t.usages[fmt.Sprintf("%s/%s", serviceKey, q.Type)] = &quotaUsage{serviceQuota: q}
+		}
+		t.loaded[serviceKey] = true
+	}
+
+	usage, ok := t.usages[fmt.Sprintf("%s/%s", serviceKey, quotaType)]
+	if !ok {
+		log.Printf("[WARN] the %s quota of %s service is not found in region %s", quotaType, service, region)
+		return nil
+	}
+
+	usage.Planned += count
+	if usage.Limit < 0 {
+		return nil
+	}
+	if usage.Used+usage.Planned > usage.Limit {
+		return fmt.Errorf("the %s quota of %s service in region %s is insufficient: the limit is %d, %d are used "+
+			"and %d are planned to be created", quotaType, service, region, usage.Limit, usage.Used, usage.Planned)
+	}
+	if usage.Used+usage.Planned == usage.Limit {
+		log.Printf("[WARN] the %s quota of %s service in region %s will be used up after this apply",
+			quotaType, service, region)
+	}
+	return nil
+}
+
+// usedUpWarning returns a warning if the quota will be used up by the planned resources, the warning is only
+// returned once for each quota.
+func (t *quotaTracker) usedUpWarning(region, service, quotaType string) diag.Diagnostics {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	usage, ok := t.usages[fmt.Sprintf("%s/%s/%s", region, service, quotaType)]
+	if !ok || usage.Limit < 0 || usage.Used+usage.Planned < usage.Limit || usage.Warned {
+		return nil
+	}
+
+	usage.Warned = true
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The %s quota of %s service is used up", quotaType, service),
+			Detail: fmt.Sprintf("The %s quota of %s service in region %s is used up after this apply: the limit is "+
+				"%d, %d were used and %d were planned to be created.", quotaType, service, region, usage.Limit,
+				usage.Used, usage.Planned),
+		},
+	}
+}
+
+// checkQuotaOnCreate returns a CustomizeDiffFunc which checks whether the resource to be created would
+// exceed the remaining quota, it only takes effect when `check_quotas_on_plan` is enabled.
+func checkQuotaOnCreate(service, quotaType string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() != "" {
+			return nil
+		}
+
+		settings := getProviderSettings(meta)
+		if settings == nil || !settings.CheckQuotasOnPlan {
+			return nil
+		}
+
+		cfg := meta.(*config.Config)
+		return settings.quotas.reserve(cfg, getResourceDiffRegion(d, cfg), service, quotaType, 1)
+	}
+}
+
+// withQuotaCheck appends the quota check to the CustomizeDiff of the resource. The diff can not carry warnings, so
+// the warning about the used up quota is returned by the creation.
+func withQuotaCheck(r *schema.Resource, service, quotaType string) *schema.Resource {
+	create := r.CreateContext
+	if create == nil {
+		legacyCreate := r.Create
+		create = func(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return diag.FromErr(legacyCreate(d, meta))
+		}
+		r.Create = nil
+	}
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := create(ctx, d, meta)
+		settings := getProviderSettings(meta)
+		if diags.HasError() || settings == nil || !settings.CheckQuotasOnPlan {
+			return diags
+		}
+
+		cfg := meta.(*config.Config)
+		return append(diags, settings.quotas.usedUpWarning(GetRegion(d, cfg), service, quotaType)...)
+	}
+
+	return withCustomizeDiff(r, checkQuotaOnCreate(service, quotaType))
+}
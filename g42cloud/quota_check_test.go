@@ -0,0 +1,52 @@
+package g42cloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestQuotaTrackerReserve(t *testing.T) {
+	tracker := newQuotaTracker()
+	tracker.loaded["ae-ad-1/ecs"] = true
+	tracker.usages["ae-ad-1/ecs/instances"] = &quotaUsage{
+		serviceQuota: serviceQuota{Type: "instances", Used: 8, Limit: 10},
+	}
+
+	if err := tracker.reserve(nil, "ae-ad-1", "ecs", "instances", 1); err != nil {
+		t.Fatalf("unexpected error when the quota is sufficient: %s", err)
+	}
+	if diags := tracker.usedUpWarning("ae-ad-1", "ecs", "instances"); len(diags) != 0 {
+		t.Fatalf("unexpected warning when the quota is not used up: %v", diags)
+	}
+
+	if err := tracker.reserve(nil, "ae-ad-1", "ecs", "instances", 1); err != nil {
+		t.Fatalf("unexpected error when the quota is used up: %s", err)
+	}
+	diags := tracker.usedUpWarning("ae-ad-1", "ecs", "instances")
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a warning when the quota is used up, but got %v", diags)
+	}
+	if diags := tracker.usedUpWarning("ae-ad-1", "ecs", "instances"); len(diags) != 0 {
+		t.Fatalf("the warning should only be returned once, but got %v", diags)
+	}
+
+	if err := tracker.reserve(nil, "ae-ad-1", "ecs", "instances", 1); err == nil {
+		t.Fatalf("expected an error when the quota is exceeded")
+	}
+}
+
+func TestQuotaTrackerReserveUnlimited(t *testing.T) {
+	tracker := newQuotaTracker()
+	tracker.loaded["ae-ad-1/vpc"] = true
+	tracker.usages["ae-ad-1/vpc/publicIp"] = &quotaUsage{
+		serviceQuota: serviceQuota{Type: "publicIp", Used: 100, Limit: -1},
+	}
+
+	if err := tracker.reserve(nil, "ae-ad-1", "vpc", "publicIp", 10); err != nil {
+		t.Fatalf("unexpected error for the unlimited quota: %s", err)
+	}
+	if diags := tracker.usedUpWarning("ae-ad-1", "vpc", "publicIp"); len(diags) != 0 {
+		t.Fatalf("unexpected warning for the unlimited quota: %v", diags)
+	}
+}
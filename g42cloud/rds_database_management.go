@@ -0,0 +1,134 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// The database engines of RDS instance, in lower case.
+const (
+	rdsEngineMySQL      = "mysql"
+	rdsEnginePostgreSQL = "postgresql"
+	rdsEngineSQLServer  = "sqlserver"
+)
+
+// rdsCharacterSets is the character sets supported by the databases of each engine.
+var rdsCharacterSets = map[string][]string{
+	rdsEngineMySQL:      {"utf8", "utf8mb4", "gbk", "latin1"},
+	rdsEnginePostgreSQL: {"UTF8", "SQL_ASCII", "LATIN1", "EUC_CN", "EUC_JP", "EUC_KR"},
+	// the character sets of SQL Server are the collations of the database
+	rdsEngineSQLServer: {"Chinese_PRC_CI_AS", "Chinese_PRC_CS_AS", "Chinese_PRC_BIN", "Japanese_CI_AS",
+		"Japanese_CS_AS", "Korean_Wansung_CI_AS", "Latin1_General_CI_AS", "Latin1_General_CS_AS",
+		"SQL_Latin1_General_CP1_CI_AS", "SQL_Latin1_General_CP1_CS_AS"},
+}
+
+// getRdsInstanceEngine returns the database engine of the instance in lower case, a 404 error is returned if the
+// instance does not exist.
+func getRdsInstanceEngine(client *golangsdk.ServiceClient, instanceID string) (string, error) {
+	instance, err := getRdsInstanceByID(client, instanceID)
+	if err != nil {
+		return "", err
+	}
+	if instance.Id == "" {
+		return "", golangsdk.ErrDefault404{}
+	}
+	return strings.ToLower(instance.DataStore.Type), nil
+}
+
+// validateRdsCharacterSet checks whether the character set is supported by the engine.
+func validateRdsCharacterSet(engine, characterSet string) error {
+	if characterSet == "" {
+		return nil
+	}
+
+	characterSets, ok := rdsCharacterSets[engine]
+	if !ok {
+		return fmt.Errorf("the character set cannot be specified for the database of %s instance", engine)
+	}
+	if !utils.StrSliceContains(characterSets, characterSet) {
+		return fmt.Errorf("the character set %s is not supported by %s, valid values are: %s", characterSet,
+			engine, strings.Join(characterSets, ", "))
+	}
+	return nil
+}
+
+// parseRdsResourceID splits the ID in the format of <instance_id>/<name>.
+func parseRdsResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID format (%s), must be <instance_id>/<name>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// requestRdsInstanceAPI sends the request to the API of the instance, the request is retried if other operations
+// of the instance are in progress.
+func requestRdsInstanceAPI(client *golangsdk.ServiceClient, method, httpUrl, instanceID string, body interface{},
+	timeout time.Duration) (interface{}, error) {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceID)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+	}
+	if body != nil {
+		opts.JSONBody = body
+	}
+
+	var respBody interface{}
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		resp, err := client.Request(method, path, &opts)
+		if err != nil {
+			log.Printf("[DEBUG] error requesting the API of RDS instance (%s): %s", instanceID, err)
+			return checkForRetryableError(err)
+		}
+		respBody, err = utils.FlattenResponse(resp)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	return respBody, err
+}
+
+// listRdsInstanceItems queries all the items of the paginated API of the instance, such as the databases and
+// accounts.
+func listRdsInstanceItems(client *golangsdk.ServiceClient, httpUrl, instanceID, key string) ([]interface{}, error) {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceID)
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	items := make([]interface{}, 0)
+	for page := 1; ; page++ {
+		resp, err := client.Request("GET", fmt.Sprintf("%s%spage=%d&limit=100", path, separator, page), &opts)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		pageItems := utils.PathSearch(key, respBody, make([]interface{}, 0)).([]interface{})
+		items = append(items, pageItems...)
+		totalCount := int(utils.PathSearch("total_count", respBody, float64(0)).(float64))
+		if len(pageItems) == 0 || len(items) >= totalCount {
+			return items, nil
+		}
+	}
+}
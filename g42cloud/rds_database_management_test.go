@@ -0,0 +1,79 @@
+package g42cloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateRdsCharacterSet(t *testing.T) {
+	cases := []struct {
+		engine       string
+		characterSet string
+		expectErr    bool
+	}{
+		{rdsEngineMySQL, "", false},
+		{rdsEngineMySQL, "utf8mb4", false},
+		{rdsEngineMySQL, "UTF8", true},
+		{rdsEnginePostgreSQL, "UTF8", false},
+		{rdsEngineSQLServer, "Chinese_PRC_CI_AS", false},
+		{rdsEngineSQLServer, "utf8", true},
+		{"unknown", "", false},
+		{"unknown", "utf8", true},
+	}
+
+	for _, tc := range cases {
+		err := validateRdsCharacterSet(tc.engine, tc.characterSet)
+		if (err != nil) != tc.expectErr {
+			t.Errorf("validateRdsCharacterSet(%q, %q) returns error %v, expected error: %t", tc.engine,
+				tc.characterSet, err, tc.expectErr)
+		}
+	}
+}
+
+func TestBuildRdsDatabaseCreateBody(t *testing.T) {
+	r := ResourceRdsDatabase()
+	cases := []struct {
+		engine   string
+		raw      map[string]interface{}
+		expected map[string]interface{}
+	}{
+		{
+			engine:   rdsEngineMySQL,
+			raw:      map[string]interface{}{"name": "test"},
+			expected: map[string]interface{}{"name": "test", "character_set": "utf8mb4"},
+		},
+		{
+			engine:   rdsEnginePostgreSQL,
+			raw:      map[string]interface{}{"name": "test", "owner": "root"},
+			expected: map[string]interface{}{"name": "test", "owner": "root"},
+		},
+		{
+			engine:   rdsEngineSQLServer,
+			raw:      map[string]interface{}{"name": "test"},
+			expected: map[string]interface{}{"name": "test"},
+		},
+		{
+			engine:   rdsEngineSQLServer,
+			raw:      map[string]interface{}{"name": "test", "character_set": "Chinese_PRC_CI_AS"},
+			expected: map[string]interface{}{"name": "test", "character_set": "Chinese_PRC_CI_AS"},
+		},
+	}
+
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, r.Schema, tc.raw)
+		body, err := buildRdsDatabaseCreateBody(d, tc.engine)
+		if err != nil {
+			t.Fatalf("buildRdsDatabaseCreateBody(%s) returns error: %s", tc.engine, err)
+		}
+		if len(body) != len(tc.expected) {
+			t.Errorf("buildRdsDatabaseCreateBody(%s) = %v, expected %v", tc.engine, body, tc.expected)
+			continue
+		}
+		for k, v := range tc.expected {
+			if body[k] != v {
+				t.Errorf("buildRdsDatabaseCreateBody(%s) = %v, expected %v", tc.engine, body, tc.expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsKafkaConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaConsumerGroupCreate,
+		Read:   resourceDmsKafkaConsumerGroupRead,
+		Update: resourceDmsKafkaConsumerGroupUpdate,
+		Delete: resourceDmsKafkaConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"coordinator_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"lag": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDmsKafkaConsumerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	params := map[string]string{"instance_id": instanceID}
+	body := map[string]interface{}{
+		"group_name": name,
+		"group_desc": d.Get("description").(string),
+	}
+	log.Printf("[DEBUG] Create DMS kafka consumer group options: %#v", body)
+	_, err = requestDmsKafkaAPI(client, "POST", "v2/{project_id}/kafka/instances/{instance_id}/group", params, body,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating DMS kafka consumer group: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, name))
+	return resourceDmsKafkaConsumerGroupRead(d, meta)
+}
+
+func resourceDmsKafkaConsumerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID, name, err := parseDmsKafkaResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	group, err := getDmsKafkaConsumerGroup(client, instanceID, name)
+	if err != nil {
+		return CheckDeleted(d, err, "DMS kafka consumer group")
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("name", name)
+	d.Set("description", utils.PathSearch("group_desc", group, nil))
+	d.Set("state", utils.PathSearch("state", group, nil))
+	d.Set("coordinator_id", utils.PathSearch("coordinator_id", group, nil))
+	d.Set("lag", utils.PathSearch("lag", group, nil))
+	d.Set("created_at", flattenDmsKafkaTimestamp(utils.PathSearch("createdAt", group, float64(0)).(float64)))
+
+	return nil
+}
+
+func resourceDmsKafkaConsumerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	params := map[string]string{
+		"instance_id": d.Get("instance_id").(string),
+		"group":       d.Get("name").(string),
+	}
+	body := map[string]interface{}{
+		"group_name": d.Get("name").(string),
+		"group_desc": d.Get("description").(string),
+	}
+	_, err = requestDmsKafkaAPI(client, "PUT", "v2/kafka/{project_id}/instances/{instance_id}/groups/{group}", params,
+		body, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error updating DMS kafka consumer group (%s): %s", d.Id(), err)
+	}
+
+	return resourceDmsKafkaConsumerGroupRead(d, meta)
+}
+
+func resourceDmsKafkaConsumerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	params := map[string]string{"instance_id": d.Get("instance_id").(string)}
+	body := map[string]interface{}{
+		"group_ids": []string{d.Get("name").(string)},
+	}
+	respBody, err := requestDmsKafkaAPI(client, "POST", "v2/{project_id}/instances/{instance_id}/groups/batch-delete",
+		params, body, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "DMS kafka consumer group")
+	}
+	// The batch deletion API returns 200 even if the group fails to be deleted, such as the group has active members.
+	if failed := utils.PathSearch("failed_groups|[0]", respBody, nil); failed != nil {
+		return fmt.Errorf("error deleting DMS kafka consumer group (%s): %v",
+			d.Id(), utils.PathSearch("error_message", failed, ""))
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package g42cloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// The timestamps which reset the offsets to the earliest and latest messages, they are the same as the special
+// timestamps of the kafka ListOffsets API.
+const (
+	dmsKafkaLatestTimestamp   int64 = -1
+	dmsKafkaEarliestTimestamp int64 = -2
+)
+
+// ResourceDmsKafkaConsumerGroupOffsetReset is an action resource which resets the consumer offsets of the group once
+// it is created, changing any argument resets the offsets again.
+func ResourceDmsKafkaConsumerGroupOffsetReset() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaConsumerGroupOffsetResetCreate,
+		Read:   resourceDmsKafkaConsumerGroupOffsetResetRead,
+		Delete: resourceDmsKafkaConsumerGroupOffsetResetDelete,
+
+		CustomizeDiff: validateDmsKafkaResetTimestamp,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"group": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topic": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"partition": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+			},
+			"reset_to": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"earliest", "latest", "timestamp"}, false),
+			},
+			"timestamp": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+// validateDmsKafkaResetTimestamp checks during the plan that the timestamp is specified only when reset_to is
+// timestamp, zero is a valid timestamp so the configuration is checked instead of the value.
+func validateDmsKafkaResetTimestamp(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	resetTo := rawConfig.GetAttr("reset_to")
+	if resetTo.IsNull() || !resetTo.IsKnown() {
+		return nil
+	}
+
+	hasTimestamp := !rawConfig.GetAttr("timestamp").IsNull()
+	if resetTo.AsString() == "timestamp" && !hasTimestamp {
+		return fmt.Errorf("the timestamp must be specified when reset_to is timestamp")
+	}
+	if resetTo.AsString() != "timestamp" && hasTimestamp {
+		return fmt.Errorf("the timestamp can only be specified when reset_to is timestamp")
+	}
+	return nil
+}
+
+// buildDmsKafkaResetTimestamp returns the timestamp in milliseconds to which the offsets are reset, the offsets are
+// reset to the earliest messages if it is earlier than them, or to the latest if it is later than them.
+func buildDmsKafkaResetTimestamp(d *schema.ResourceData) int64 {
+	switch d.Get("reset_to").(string) {
+	case "earliest":
+		return dmsKafkaEarliestTimestamp
+	case "latest":
+		return dmsKafkaLatestTimestamp
+	default:
+		return int64(d.Get("timestamp").(int))
+	}
+}
+
+func resourceDmsKafkaConsumerGroupOffsetResetCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	group := d.Get("group").(string)
+	topic := d.Get("topic").(string)
+	params := map[string]string{
+		"instance_id": instanceID,
+		"group":       group,
+	}
+	body := map[string]interface{}{
+		"topic":     topic,
+		"partition": d.Get("partition").(int),
+		"timestamp": buildDmsKafkaResetTimestamp(d),
+	}
+	log.Printf("[DEBUG] Reset DMS kafka consumer group offset options: %#v", body)
+	_, err = requestDmsKafkaAPI(client, "POST",
+		"v2/{project_id}/instances/{instance_id}/management/groups/{group}/reset-message-offset", params, body,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error resetting the offsets of DMS kafka consumer group (%s): %s", group, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, group, topic))
+	return resourceDmsKafkaConsumerGroupOffsetResetRead(d, meta)
+}
+
+func resourceDmsKafkaConsumerGroupOffsetResetRead(_ *schema.ResourceData, _ interface{}) error {
+	// The reset is an one-time action, there is nothing to refresh.
+	return nil
+}
+
+func resourceDmsKafkaConsumerGroupOffsetResetDelete(d *schema.ResourceData, _ interface{}) error {
+	log.Printf("[WARN] the offsets of DMS kafka consumer group (%s) cannot be restored, the reset is only removed "+
+		"from the state", d.Id())
+	return nil
+}
@@ -0,0 +1,56 @@
+package g42cloud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestValidateDmsKafkaResetTimestamp(t *testing.T) {
+	cases := []struct {
+		name      string
+		config    map[string]interface{}
+		expectErr bool
+	}{
+		{
+			name:   "earliest",
+			config: map[string]interface{}{"reset_to": "earliest"},
+		},
+		{
+			name:   "zero timestamp",
+			config: map[string]interface{}{"reset_to": "timestamp", "timestamp": 0},
+		},
+		{
+			name:      "missing timestamp",
+			config:    map[string]interface{}{"reset_to": "timestamp"},
+			expectErr: true,
+		},
+		{
+			name:      "timestamp with latest",
+			config:    map[string]interface{}{"reset_to": "latest", "timestamp": 1697600000000},
+			expectErr: true,
+		},
+	}
+
+	r := ResourceDmsKafkaConsumerGroupOffsetReset()
+	for _, tc := range cases {
+		tc.config["instance_id"] = "instance-id"
+		tc.config["group"] = "group"
+		tc.config["topic"] = "topic"
+		// the raw configuration is passed along with the prior state during the plan
+		rawConfig := map[string]cty.Value{
+			"reset_to":  cty.StringVal(tc.config["reset_to"].(string)),
+			"timestamp": cty.NullVal(cty.Number),
+		}
+		if v, ok := tc.config["timestamp"]; ok {
+			rawConfig["timestamp"] = cty.NumberIntVal(int64(v.(int)))
+		}
+		state := &terraform.InstanceState{RawConfig: cty.ObjectVal(rawConfig)}
+		_, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(tc.config), nil)
+		if (err != nil) != tc.expectErr {
+			t.Errorf("%s: the plan returns error %v, expected error: %t", tc.name, err, tc.expectErr)
+		}
+	}
+}
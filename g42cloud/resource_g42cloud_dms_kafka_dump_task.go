@@ -0,0 +1,248 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsKafkaDumpTask dumps the messages of the Kafka topics to the OBS bucket, the task is run by the connector
+// nodes of the Smart Connect.
+func ResourceDmsKafkaDumpTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaDumpTaskCreate,
+		Read:   resourceDmsKafkaDumpTaskRead,
+		Delete: resourceDmsKafkaDumpTaskDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"connector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"topics": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"topics_regex"},
+			},
+			"topics_regex": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"obs_bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"obs_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"partition_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"yyyy", "yyyy/MM", "yyyy/MM/dd", "yyyy/MM/dd/HH", "yyyy/MM/dd/HH/mm",
+				}, false),
+			},
+			"deliver_time_interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, 900),
+			},
+			"consumer_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "latest",
+				ValidateFunc: validation.StringInSlice([]string{"latest", "earliest"}, false),
+			},
+			"record_delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"agency_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"access_key"},
+			},
+			"access_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"secret_key"},
+			},
+			"secret_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"access_key"},
+			},
+			"destination_file_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildDmsKafkaObsDestinationDescriptor(d *schema.ResourceData) map[string]interface{} {
+	topics := utils.ExpandToStringList(d.Get("topics").([]interface{}))
+	descriptor := map[string]interface{}{
+		"topics":                utils.ValueIngoreEmpty(strings.Join(topics, ",")),
+		"topics_regex":          utils.ValueIngoreEmpty(d.Get("topics_regex")),
+		"consumer_strategy":     d.Get("consumer_strategy").(string),
+		"destination_file_type": "TEXT",
+		"obs_bucket_name":       d.Get("obs_bucket_name").(string),
+		"obs_path":              utils.ValueIngoreEmpty(d.Get("obs_path")),
+		"partition_format":      utils.ValueIngoreEmpty(d.Get("partition_format")),
+		"record_delimiter":      utils.ValueIngoreEmpty(d.Get("record_delimiter")),
+		"deliver_time_interval": d.Get("deliver_time_interval").(int),
+		"agency_name":           utils.ValueIngoreEmpty(d.Get("agency_name")),
+		"access_key":            utils.ValueIngoreEmpty(d.Get("access_key")),
+		"secret_key":            utils.ValueIngoreEmpty(d.Get("secret_key")),
+	}
+	return utils.RemoveNil(descriptor)
+}
+
+func resourceDmsKafkaDumpTaskCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	connectorID := d.Get("connector_id").(string)
+	params := map[string]string{"connector_id": connectorID}
+	body := map[string]interface{}{
+		"source_type":                "BLOB",
+		"task_name":                  d.Get("name").(string),
+		"destination_type":           "OBS",
+		"obs_destination_descriptor": buildDmsKafkaObsDestinationDescriptor(d),
+	}
+	log.Printf("[DEBUG] Create DMS kafka dump task: %s", d.Get("name").(string))
+	respBody, err := requestDmsKafkaAPI(client, "POST", "v2/{project_id}/connectors/{connector_id}/sink-tasks",
+		params, body, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating DMS kafka dump task: %s", err)
+	}
+	taskID := utils.PathSearch("task_id", respBody, "").(string)
+	if taskID == "" {
+		return fmt.Errorf("error creating DMS kafka dump task: task ID is not found in the response")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", connectorID, taskID))
+	return resourceDmsKafkaDumpTaskRead(d, meta)
+}
+
+func resourceDmsKafkaDumpTaskRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	connectorID, taskID, err := parseDmsKafkaResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	task, err := getDmsKafkaAPI(client, "v2/{project_id}/connectors/{connector_id}/sink-tasks/{task_id}",
+		map[string]string{"connector_id": connectorID, "task_id": taskID}, nil)
+	if err != nil {
+		return CheckDeleted(d, err, "DMS kafka dump task")
+	}
+
+	d.Set("region", region)
+	d.Set("connector_id", connectorID)
+	d.Set("name", utils.PathSearch("task_name", task, nil))
+	topics := utils.PathSearch("topics", task, "").(string)
+	if _, ok := d.GetOk("topics_regex"); ok {
+		d.Set("topics_regex", topics)
+	} else if topics != "" {
+		d.Set("topics", strings.Split(topics, ","))
+	}
+
+	descriptor := utils.PathSearch("obs_destination_descriptor", task, nil)
+	d.Set("obs_bucket_name", utils.PathSearch("obs_bucket_name", descriptor, nil))
+	d.Set("obs_path", utils.PathSearch("obs_path", descriptor, nil))
+	d.Set("partition_format", utils.PathSearch("partition_format", descriptor, nil))
+	d.Set("deliver_time_interval", utils.PathSearch("deliver_time_interval", descriptor, nil))
+	d.Set("consumer_strategy", utils.PathSearch("consumer_strategy", descriptor, nil))
+	d.Set("record_delimiter", utils.PathSearch("record_delimiter", descriptor, nil))
+	d.Set("destination_file_type", utils.PathSearch("destination_file_type", descriptor, nil))
+	d.Set("status", utils.PathSearch("status", task, nil))
+	d.Set("created_at", flattenDmsKafkaTimestamp(utils.PathSearch("create_time", task, float64(0)).(float64)))
+
+	return nil
+}
+
+func resourceDmsKafkaDumpTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	connectorID, taskID, err := parseDmsKafkaResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	params := map[string]string{
+		"connector_id": connectorID,
+		"task_id":      taskID,
+	}
+	_, err = requestDmsKafkaAPI(client, "DELETE", "v2/{project_id}/connectors/{connector_id}/sink-tasks/{task_id}",
+		params, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "DMS kafka dump task")
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package g42cloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsKafkaSmartConnect enables the Smart Connect of the Kafka instance, the connector nodes are used to run
+// the dump tasks.
+func ResourceDmsKafkaSmartConnect() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsKafkaSmartConnectCreate,
+		Read:   resourceDmsKafkaSmartConnectRead,
+		Delete: resourceDmsKafkaSmartConnectDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"storage_spec_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bandwidth": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(2),
+			},
+			"connector_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// isDmsKafkaConnectorEnabled checks whether the Smart Connect of the instance is enabled with the connector.
+func isDmsKafkaConnectorEnabled(instance interface{}, connectorID string) bool {
+	return utils.PathSearch("connector_enable", instance, false).(bool) &&
+		utils.PathSearch("connector_id", instance, "").(string) == connectorID
+}
+
+func resourceDmsKafkaSmartConnectCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	specCode := d.Get("storage_spec_code").(string)
+	if specCode == "" {
+		// the connector nodes use the same storage as the instance by default
+		instance, err := getDmsKafkaInstance(client, instanceID)
+		if err != nil {
+			return fmt.Errorf("error getting DMS kafka instance (%s): %s", instanceID, err)
+		}
+		specCode = utils.PathSearch("storage_spec_code", instance, "").(string)
+	}
+
+	params := map[string]string{"instance_id": instanceID}
+	body := map[string]interface{}{
+		"spec_code":     specCode,
+		"node_cnt":      fmt.Sprint(d.Get("node_count").(int)),
+		"specification": utils.ValueIngoreEmpty(d.Get("bandwidth")),
+	}
+	log.Printf("[DEBUG] Create DMS kafka smart connect options: %#v", body)
+	respBody, err := requestDmsKafkaAPI(client, "POST", "v2/{project_id}/instances/{instance_id}/connector", params,
+		utils.RemoveNil(body), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error enabling the smart connect of DMS kafka instance (%s): %s", instanceID, err)
+	}
+	connectorID := utils.PathSearch("connector_id", respBody, "").(string)
+	if connectorID == "" {
+		return fmt.Errorf("error enabling the smart connect of DMS kafka instance (%s): connector ID is not found",
+			instanceID)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, connectorID))
+
+	err = waitForDmsKafkaInstanceRunning(context.Background(), client, instanceID, d.Timeout(schema.TimeoutCreate),
+		func(instance interface{}) bool {
+			return isDmsKafkaConnectorEnabled(instance, connectorID)
+		})
+	if err != nil {
+		return fmt.Errorf("error waiting for the smart connect of DMS kafka instance (%s) to be enabled: %s",
+			instanceID, err)
+	}
+
+	return resourceDmsKafkaSmartConnectRead(d, meta)
+}
+
+func resourceDmsKafkaSmartConnectRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID, connectorID, err := parseDmsKafkaResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	instance, err := getDmsKafkaInstance(client, instanceID)
+	if err == nil && !isDmsKafkaConnectorEnabled(instance, connectorID) {
+		err = golangsdk.ErrDefault404{}
+	}
+	if err != nil {
+		return CheckDeleted(d, err, "DMS kafka smart connect")
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("connector_id", connectorID)
+	if _, ok := d.GetOk("storage_spec_code"); !ok {
+		d.Set("storage_spec_code", utils.PathSearch("storage_spec_code", instance, nil))
+	}
+
+	return nil
+}
+
+func resourceDmsKafkaSmartConnectDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	params := map[string]string{"instance_id": instanceID}
+	_, err = requestDmsKafkaAPI(client, "POST", "v2/{project_id}/kafka/instances/{instance_id}/delete-connector",
+		params, map[string]interface{}{}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "DMS kafka smart connect")
+	}
+
+	err = waitForDmsKafkaInstanceRunning(context.Background(), client, instanceID, d.Timeout(schema.TimeoutDelete),
+		func(instance interface{}) bool {
+			return !utils.PathSearch("connector_enable", instance, false).(bool)
+		})
+	if err != nil {
+		return fmt.Errorf("error waiting for the smart connect of DMS kafka instance (%s) to be disabled: %s",
+			instanceID, err)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsRabbitmqExchange() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsRabbitmqExchangeCreate,
+		Read:   resourceDmsRabbitmqExchangeRead,
+		Delete: resourceDmsRabbitmqExchangeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vhost": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"direct", "fanout", "topic", "headers",
+				}, false),
+			},
+			"durable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"auto_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"internal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitmqExchangeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	name := d.Get("name").(string)
+	params := map[string]string{
+		"instance_id": instanceID,
+		"vhost":       vhost,
+	}
+	body := map[string]interface{}{
+		"name":        name,
+		"type":        d.Get("type").(string),
+		"durable":     d.Get("durable").(bool),
+		"auto_delete": d.Get("auto_delete").(bool),
+		"internal":    d.Get("internal").(bool),
+	}
+	log.Printf("[DEBUG] Create DMS RabbitMQ exchange options: %#v", body)
+	_, err = requestDmsRabbitmqAPI(client, "POST",
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges", params, body,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating DMS RabbitMQ exchange: %s", err)
+	}
+
+	d.SetId(buildDmsRabbitmqResourceID(instanceID, vhost, name))
+	return resourceDmsRabbitmqExchangeRead(d, meta)
+}
+
+func resourceDmsRabbitmqExchangeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	parts, err := parseDmsRabbitmqResourceID(d.Id(), "<instance_id>/<vhost>/<name>")
+	if err != nil {
+		return err
+	}
+	params := map[string]string{
+		"instance_id": parts[0],
+		"vhost":       parts[1],
+	}
+	exchange, err := getDmsRabbitmqItem(client,
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges", params, "items", "name", parts[2])
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ exchange")
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", parts[0])
+	d.Set("vhost", parts[1])
+	d.Set("name", parts[2])
+	d.Set("type", utils.PathSearch("type", exchange, nil))
+	d.Set("durable", utils.PathSearch("durable", exchange, false))
+	d.Set("auto_delete", utils.PathSearch("auto_delete", exchange, false))
+	d.Set("internal", utils.PathSearch("internal", exchange, false))
+
+	return nil
+}
+
+func resourceDmsRabbitmqExchangeDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	params := map[string]string{
+		"instance_id": d.Get("instance_id").(string),
+		"vhost":       d.Get("vhost").(string),
+	}
+	body := map[string]interface{}{
+		"name": []string{d.Get("name").(string)},
+	}
+	_, err = requestDmsRabbitmqAPI(client, "DELETE",
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges", params, body,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ exchange")
+	}
+	return nil
+}
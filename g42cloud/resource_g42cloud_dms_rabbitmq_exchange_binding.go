@@ -0,0 +1,207 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsRabbitmqExchangeBinding() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsRabbitmqExchangeBindingCreate,
+		Read:   resourceDmsRabbitmqExchangeBindingRead,
+		Delete: resourceDmsRabbitmqExchangeBindingDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vhost": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"destination_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Queue", "Exchange"}, false),
+			},
+			"destination": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"routing_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"properties_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// getDmsRabbitmqExchangeBinding finds the binding from the bindings of the source exchange, a 404 error is returned
+// if it is not found. The binding is matched by the properties key if it is specified, otherwise by the routing key.
+func getDmsRabbitmqExchangeBinding(client *golangsdk.ServiceClient, instanceID, vhost, source, destinationType,
+	destination, routingKey, propertiesKey string) (interface{}, error) {
+	params := map[string]string{
+		"instance_id": instanceID,
+		"vhost":       vhost,
+		"exchange":    source,
+	}
+	bindings, err := listDmsRabbitmqItems(client,
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges/{exchange}/binding", params,
+		"items")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, binding := range bindings {
+		// The destination type is returned in lower case.
+		if !strings.EqualFold(utils.PathSearch("destination_type", binding, "").(string), destinationType) ||
+			utils.PathSearch("destination", binding, "").(string) != destination {
+			continue
+		}
+		if propertiesKey != "" {
+			if utils.PathSearch("properties_key", binding, "").(string) == propertiesKey {
+				return binding, nil
+			}
+		} else if utils.PathSearch("routing_key", binding, "").(string) == routingKey {
+			return binding, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceDmsRabbitmqExchangeBindingCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	source := d.Get("source").(string)
+	destinationType := d.Get("destination_type").(string)
+	destination := d.Get("destination").(string)
+	routingKey := d.Get("routing_key").(string)
+	params := map[string]string{
+		"instance_id": instanceID,
+		"vhost":       vhost,
+		"exchange":    source,
+	}
+	body := map[string]interface{}{
+		"destination_type": destinationType,
+		"destination":      destination,
+		"routing_key":      routingKey,
+	}
+	log.Printf("[DEBUG] Create DMS RabbitMQ exchange binding options: %#v", body)
+	_, err = requestDmsRabbitmqAPI(client, "POST",
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges/{exchange}/binding", params, body,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating DMS RabbitMQ exchange binding: %s", err)
+	}
+
+	// The properties key which identifies the binding is generated by RabbitMQ, query it from the bindings.
+	binding, err := getDmsRabbitmqExchangeBinding(client, instanceID, vhost, source, destinationType, destination,
+		routingKey, "")
+	if err != nil {
+		return fmt.Errorf("error getting the properties key of DMS RabbitMQ exchange binding: %s", err)
+	}
+	propertiesKey := utils.PathSearch("properties_key", binding, "").(string)
+
+	d.SetId(buildDmsRabbitmqResourceID(instanceID, vhost, source, destinationType, destination, propertiesKey))
+	return resourceDmsRabbitmqExchangeBindingRead(d, meta)
+}
+
+func resourceDmsRabbitmqExchangeBindingRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	parts, err := parseDmsRabbitmqResourceID(d.Id(),
+		"<instance_id>/<vhost>/<source>/<destination_type>/<destination>/<properties_key>")
+	if err != nil {
+		return err
+	}
+	binding, err := getDmsRabbitmqExchangeBinding(client, parts[0], parts[1], parts[2], parts[3], parts[4], "",
+		parts[5])
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ exchange binding")
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", parts[0])
+	d.Set("vhost", parts[1])
+	d.Set("source", parts[2])
+	d.Set("destination_type", parts[3])
+	d.Set("destination", parts[4])
+	d.Set("routing_key", utils.PathSearch("routing_key", binding, nil))
+	d.Set("properties_key", parts[5])
+
+	return nil
+}
+
+func resourceDmsRabbitmqExchangeBindingDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	params := map[string]string{
+		"instance_id":      d.Get("instance_id").(string),
+		"vhost":            d.Get("vhost").(string),
+		"exchange":         d.Get("source").(string),
+		"destination_type": d.Get("destination_type").(string),
+		"destination":      d.Get("destination").(string),
+		"properties_key":   d.Get("properties_key").(string),
+	}
+	_, err = requestDmsRabbitmqAPI(client, "DELETE",
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges/{exchange}"+
+			"/destination-type/{destination_type}/destination/{destination}/properties-key/{properties_key}/unbinding",
+		params, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ exchange binding")
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsRabbitmqQueue() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsRabbitmqQueueCreate,
+		Read:   resourceDmsRabbitmqQueueRead,
+		Delete: resourceDmsRabbitmqQueueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vhost": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"durable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+			"auto_delete": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dead_letter_exchange": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"dead_letter_routing_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"dead_letter_exchange"},
+			},
+			"message_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"lazy_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"lazy"}, false),
+			},
+			"messages": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"consumers": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitmqQueueCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	name := d.Get("name").(string)
+	params := map[string]string{
+		"instance_id": instanceID,
+		"vhost":       vhost,
+	}
+	body := map[string]interface{}{
+		"name":                    name,
+		"durable":                 d.Get("durable").(bool),
+		"auto_delete":             d.Get("auto_delete").(bool),
+		"dead_letter_exchange":    utils.ValueIngoreEmpty(d.Get("dead_letter_exchange")),
+		"dead_letter_routing_key": utils.ValueIngoreEmpty(d.Get("dead_letter_routing_key")),
+		"message_ttl":             utils.ValueIngoreEmpty(d.Get("message_ttl")),
+		"lazy_mode":               utils.ValueIngoreEmpty(d.Get("lazy_mode")),
+	}
+	body = utils.RemoveNil(body)
+	log.Printf("[DEBUG] Create DMS RabbitMQ queue options: %#v", body)
+	_, err = requestDmsRabbitmqAPI(client, "POST",
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/queues", params, body,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating DMS RabbitMQ queue: %s", err)
+	}
+
+	d.SetId(buildDmsRabbitmqResourceID(instanceID, vhost, name))
+	return resourceDmsRabbitmqQueueRead(d, meta)
+}
+
+func resourceDmsRabbitmqQueueRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	parts, err := parseDmsRabbitmqResourceID(d.Id(), "<instance_id>/<vhost>/<name>")
+	if err != nil {
+		return err
+	}
+	params := map[string]string{
+		"instance_id": parts[0],
+		"vhost":       parts[1],
+	}
+	queue, err := getDmsRabbitmqItem(client,
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/queues", params, "items", "name", parts[2])
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ queue")
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", parts[0])
+	d.Set("vhost", parts[1])
+	d.Set("name", parts[2])
+	d.Set("durable", utils.PathSearch("durable", queue, false))
+	d.Set("auto_delete", utils.PathSearch("auto_delete", queue, false))
+	// The queue arguments are returned in the form of RabbitMQ optional arguments.
+	d.Set("dead_letter_exchange", utils.PathSearch(`arguments."x-dead-letter-exchange"`, queue, nil))
+	d.Set("dead_letter_routing_key", utils.PathSearch(`arguments."x-dead-letter-routing-key"`, queue, nil))
+	d.Set("message_ttl", utils.PathSearch(`arguments."x-message-ttl"`, queue, nil))
+	d.Set("lazy_mode", utils.PathSearch(`arguments."x-queue-mode"`, queue, nil))
+	d.Set("messages", utils.PathSearch("messages", queue, nil))
+	d.Set("consumers", utils.PathSearch("consumers", queue, nil))
+
+	return nil
+}
+
+func resourceDmsRabbitmqQueueDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	params := map[string]string{
+		"instance_id": d.Get("instance_id").(string),
+		"vhost":       d.Get("vhost").(string),
+	}
+	body := map[string]interface{}{
+		"name": []string{d.Get("name").(string)},
+	}
+	_, err = requestDmsRabbitmqAPI(client, "DELETE",
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/queues", params, body,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ queue")
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsRabbitmqUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsRabbitmqUserCreate,
+		Read:   resourceDmsRabbitmqUserRead,
+		Update: resourceDmsRabbitmqUserUpdate,
+		Delete: resourceDmsRabbitmqUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"secret_key": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"vhosts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vhost": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"conf": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"write": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"read": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildDmsRabbitmqUserVhosts(d *schema.ResourceData) []map[string]interface{} {
+	vhosts := d.Get("vhosts").(*schema.Set).List()
+	result := make([]map[string]interface{}, len(vhosts))
+	for i, v := range vhosts {
+		vhost := v.(map[string]interface{})
+		result[i] = map[string]interface{}{
+			"vhost": vhost["vhost"],
+			"conf":  vhost["conf"],
+			"write": vhost["write"],
+			"read":  vhost["read"],
+		}
+	}
+	return result
+}
+
+func flattenDmsRabbitmqUserVhosts(user interface{}) []map[string]interface{} {
+	vhosts := utils.PathSearch("vhosts", user, make([]interface{}, 0)).([]interface{})
+	result := make([]map[string]interface{}, len(vhosts))
+	for i, v := range vhosts {
+		result[i] = map[string]interface{}{
+			"vhost": utils.PathSearch("vhost", v, nil),
+			"conf":  utils.PathSearch("conf", v, nil),
+			"write": utils.PathSearch("write", v, nil),
+			"read":  utils.PathSearch("read", v, nil),
+		}
+	}
+	return result
+}
+
+func resourceDmsRabbitmqUserCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	accessKey := d.Get("access_key").(string)
+	params := map[string]string{"instance_id": instanceID}
+	body := map[string]interface{}{
+		"access_key": accessKey,
+		"secret_key": d.Get("secret_key").(string),
+		"vhosts":     buildDmsRabbitmqUserVhosts(d),
+	}
+	log.Printf("[DEBUG] Create DMS RabbitMQ user: %s", accessKey)
+	_, err = requestDmsRabbitmqAPI(client, "POST", "v2/{project_id}/instances/{instance_id}/users", params, body,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating DMS RabbitMQ user: %s", err)
+	}
+
+	d.SetId(buildDmsRabbitmqResourceID(instanceID, accessKey))
+	return resourceDmsRabbitmqUserRead(d, meta)
+}
+
+func resourceDmsRabbitmqUserRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	parts, err := parseDmsRabbitmqResourceID(d.Id(), "<instance_id>/<access_key>")
+	if err != nil {
+		return err
+	}
+	user, err := getDmsRabbitmqItem(client, "v2/{project_id}/instances/{instance_id}/users",
+		map[string]string{"instance_id": parts[0]}, "users", "access_key", parts[1])
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ user")
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", parts[0])
+	d.Set("access_key", parts[1])
+	if err := d.Set("vhosts", flattenDmsRabbitmqUserVhosts(user)); err != nil {
+		return fmt.Errorf("error saving vhosts of DMS RabbitMQ user: %s", err)
+	}
+
+	return nil
+}
+
+func resourceDmsRabbitmqUserUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	params := map[string]string{
+		"instance_id": d.Get("instance_id").(string),
+		"user_name":   d.Get("access_key").(string),
+	}
+	body := map[string]interface{}{
+		"vhosts": buildDmsRabbitmqUserVhosts(d),
+	}
+	if d.HasChange("secret_key") {
+		body["secret_key"] = d.Get("secret_key").(string)
+	}
+	_, err = requestDmsRabbitmqAPI(client, "PUT", "v2/{project_id}/instances/{instance_id}/users/{user_name}", params,
+		body, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return fmt.Errorf("error updating DMS RabbitMQ user (%s): %s", d.Id(), err)
+	}
+
+	return resourceDmsRabbitmqUserRead(d, meta)
+}
+
+func resourceDmsRabbitmqUserDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	params := map[string]string{
+		"instance_id": d.Get("instance_id").(string),
+		"user_name":   d.Get("access_key").(string),
+	}
+	_, err = requestDmsRabbitmqAPI(client, "DELETE", "v2/{project_id}/instances/{instance_id}/users/{user_name}",
+		params, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ user")
+	}
+	return nil
+}
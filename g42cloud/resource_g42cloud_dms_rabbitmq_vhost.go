@@ -0,0 +1,120 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceDmsRabbitmqVhost() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDmsRabbitmqVhostCreate,
+		Read:   resourceDmsRabbitmqVhostRead,
+		Delete: resourceDmsRabbitmqVhostDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"tracing": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitmqVhostCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	params := map[string]string{"instance_id": instanceID}
+	body := map[string]interface{}{
+		"name": name,
+	}
+	log.Printf("[DEBUG] Create DMS RabbitMQ vhost options: %#v", body)
+	_, err = requestDmsRabbitmqAPI(client, "POST", "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts", params,
+		body, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating DMS RabbitMQ vhost: %s", err)
+	}
+
+	d.SetId(buildDmsRabbitmqResourceID(instanceID, name))
+	return resourceDmsRabbitmqVhostRead(d, meta)
+}
+
+func resourceDmsRabbitmqVhostRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.DmsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	parts, err := parseDmsRabbitmqResourceID(d.Id(), "<instance_id>/<name>")
+	if err != nil {
+		return err
+	}
+	vhost, err := getDmsRabbitmqItem(client, "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts",
+		map[string]string{"instance_id": parts[0]}, "items", "name", parts[1])
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ vhost")
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", parts[0])
+	d.Set("name", parts[1])
+	d.Set("tracing", utils.PathSearch("tracing", vhost, false))
+
+	return nil
+}
+
+func resourceDmsRabbitmqVhostDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.DmsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud DMS client: %s", err)
+	}
+
+	params := map[string]string{"instance_id": d.Get("instance_id").(string)}
+	body := map[string]interface{}{
+		"name": []string{d.Get("name").(string)},
+	}
+	_, err = requestDmsRabbitmqAPI(client, "DELETE", "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts", params,
+		body, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "DMS RabbitMQ vhost")
+	}
+	return nil
+}
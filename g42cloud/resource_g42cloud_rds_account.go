@@ -0,0 +1,192 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsAccountCreate,
+		Read:   resourceRdsAccountRead,
+		Update: resourceRdsAccountUpdate,
+		Delete: resourceRdsAccountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceRdsAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	engine, err := getRdsInstanceEngine(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting RDS instance (%s): %s", instanceID, err)
+	}
+	description := d.Get("description").(string)
+	if description != "" && engine != rdsEngineMySQL {
+		return fmt.Errorf("the description can only be specified for the account of MySQL instance")
+	}
+
+	body := map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"comment": utils.ValueIngoreEmpty(description),
+	}
+	log.Printf("[DEBUG] Create RDS account options: %#v", body)
+	// Add password here so it wouldn't go in the above log entry
+	body["password"] = d.Get("password").(string)
+
+	_, err = requestRdsInstanceAPI(client, "POST", "v3/{project_id}/instances/{instance_id}/db_user", instanceID,
+		utils.RemoveNil(body), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating RDS account: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, d.Get("name").(string)))
+	return resourceRdsAccountRead(d, meta)
+}
+
+func resourceRdsAccountRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.RdsV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID, userName, err := parseRdsResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	engine, err := getRdsInstanceEngine(client, instanceID)
+	if err != nil {
+		return CheckDeleted(d, err, "RDS instance")
+	}
+
+	users, err := listRdsInstanceItems(client, "v3/{project_id}/instances/{instance_id}/db_user/detail",
+		instanceID, "users")
+	if err != nil {
+		return fmt.Errorf("error querying the accounts of RDS instance (%s): %s", instanceID, err)
+	}
+	user := utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", userName), users, nil)
+	if user == nil {
+		log.Printf("[WARN] the RDS account (%s) is not found, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("name", userName)
+	d.Set("engine", engine)
+	if engine == rdsEngineMySQL {
+		d.Set("description", utils.PathSearch("comment", user, nil))
+	}
+
+	return nil
+}
+
+func resourceRdsAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	userName := d.Get("name").(string)
+	if d.HasChange("password") {
+		body := map[string]interface{}{
+			"name":     userName,
+			"password": d.Get("password").(string),
+		}
+		_, err = requestRdsInstanceAPI(client, "POST", "v3/{project_id}/instances/{instance_id}/db_user/resetpwd",
+			instanceID, body, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error resetting the password of RDS account (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("description") {
+		if d.Get("engine").(string) != rdsEngineMySQL {
+			return fmt.Errorf("the description can only be specified for the account of MySQL instance")
+		}
+		body := map[string]interface{}{
+			"comment": d.Get("description").(string),
+		}
+		httpUrl := fmt.Sprintf("v3/{project_id}/instances/{instance_id}/db-users/%s/comment", userName)
+		_, err = requestRdsInstanceAPI(client, "PUT", httpUrl, instanceID, body, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("error updating the description of RDS account (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceRdsAccountRead(d, meta)
+}
+
+func resourceRdsAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	httpUrl := fmt.Sprintf("v3/{project_id}/instances/{instance_id}/db_user/%s", d.Get("name").(string))
+	_, err = requestRdsInstanceAPI(client, "DELETE", httpUrl, instanceID, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "RDS account")
+	}
+
+	return nil
+}
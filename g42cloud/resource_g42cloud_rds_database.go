@@ -0,0 +1,190 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsDatabase() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsDatabaseCreate,
+		Read:   resourceRdsDatabaseRead,
+		Delete: resourceRdsDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"character_set": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"owner": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildRdsDatabaseCreateBody builds the request body of the database according to the engine.
+func buildRdsDatabaseCreateBody(d *schema.ResourceData, engine string) (map[string]interface{}, error) {
+	characterSet := d.Get("character_set").(string)
+	if err := validateRdsCharacterSet(engine, characterSet); err != nil {
+		return nil, err
+	}
+
+	owner := d.Get("owner").(string)
+	if owner != "" && engine != rdsEnginePostgreSQL {
+		return nil, fmt.Errorf("the owner can only be specified for the database of PostgreSQL instance")
+	}
+	description := d.Get("description").(string)
+	if description != "" && engine != rdsEngineMySQL {
+		return nil, fmt.Errorf("the description can only be specified for the database of MySQL instance")
+	}
+
+	body := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+	switch engine {
+	case rdsEngineMySQL:
+		if characterSet == "" {
+			characterSet = "utf8mb4"
+		}
+		body["character_set"] = characterSet
+		body["comment"] = utils.ValueIngoreEmpty(description)
+	case rdsEnginePostgreSQL:
+		body["character_set"] = utils.ValueIngoreEmpty(characterSet)
+		body["owner"] = utils.ValueIngoreEmpty(owner)
+	case rdsEngineSQLServer:
+		body["character_set"] = utils.ValueIngoreEmpty(characterSet)
+	}
+	return utils.RemoveNil(body), nil
+}
+
+func resourceRdsDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	engine, err := getRdsInstanceEngine(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting RDS instance (%s): %s", instanceID, err)
+	}
+	body, err := buildRdsDatabaseCreateBody(d, engine)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Create RDS database options: %#v", body)
+	_, err = requestRdsInstanceAPI(client, "POST", "v3/{project_id}/instances/{instance_id}/database", instanceID,
+		body, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating RDS database: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, d.Get("name").(string)))
+	return resourceRdsDatabaseRead(d, meta)
+}
+
+func resourceRdsDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.RdsV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID, dbName, err := parseRdsResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	engine, err := getRdsInstanceEngine(client, instanceID)
+	if err != nil {
+		return CheckDeleted(d, err, "RDS instance")
+	}
+
+	databases, err := listRdsInstanceItems(client, "v3/{project_id}/instances/{instance_id}/database/detail",
+		instanceID, "databases")
+	if err != nil {
+		return fmt.Errorf("error querying the databases of RDS instance (%s): %s", instanceID, err)
+	}
+	database := utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", dbName), databases, nil)
+	if database == nil {
+		log.Printf("[WARN] the RDS database (%s) is not found, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("name", dbName)
+	d.Set("engine", engine)
+	d.Set("character_set", utils.PathSearch("character_set", database, nil))
+	d.Set("owner", utils.PathSearch("owner", database, nil))
+	if engine == rdsEngineMySQL {
+		d.Set("description", utils.PathSearch("comment", database, nil))
+	}
+
+	return nil
+}
+
+func resourceRdsDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	httpUrl := fmt.Sprintf("v3/{project_id}/instances/{instance_id}/database/%s", d.Get("name").(string))
+	_, err = requestRdsInstanceAPI(client, "DELETE", httpUrl, instanceID, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "RDS database")
+	}
+
+	return nil
+}
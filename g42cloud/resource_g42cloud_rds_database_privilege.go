@@ -0,0 +1,269 @@
+package g42cloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// rdsPrivilegeBatchSize is the maximum number of the users in a request of granting or revoking the privileges.
+const rdsPrivilegeBatchSize = 50
+
+func ResourceRdsDatabasePrivilege() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsDatabasePrivilegeCreate,
+		Read:   resourceRdsDatabasePrivilegeRead,
+		Update: resourceRdsDatabasePrivilegeUpdate,
+		Delete: resourceRdsDatabasePrivilegeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: validateRdsDatabasePrivilegeRevoke,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"users": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"readonly": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"schema_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// buildRdsPrivilegeUsers builds the users of the request body according to the engine, the schema name is required by
+// PostgreSQL and not supported by the others.
+func buildRdsPrivilegeUsers(engine string, rawUsers []interface{}, revoke bool) ([]map[string]interface{}, error) {
+	users := make([]map[string]interface{}, len(rawUsers))
+	for i, v := range rawUsers {
+		raw := v.(map[string]interface{})
+		schemaName := raw["schema_name"].(string)
+		if engine == rdsEnginePostgreSQL && schemaName == "" {
+			return nil, fmt.Errorf("the schema_name of user %s must be specified for PostgreSQL instance", raw["name"])
+		}
+		if engine != rdsEnginePostgreSQL && schemaName != "" {
+			return nil, fmt.Errorf("the schema_name of user %s can only be specified for PostgreSQL instance",
+				raw["name"])
+		}
+
+		users[i] = map[string]interface{}{
+			"name": raw["name"],
+		}
+		if !revoke {
+			users[i]["readonly"] = raw["readonly"]
+			if schemaName != "" {
+				users[i]["schema_name"] = schemaName
+			}
+		}
+	}
+	return users, nil
+}
+
+// validateRdsDatabasePrivilegeRevoke rejects the changes which revoke the privileges of PostgreSQL database at plan
+// time, since the privileges of PostgreSQL database cannot be revoked.
+func validateRdsDatabasePrivilegeRevoke(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || d.Get("engine").(string) != rdsEnginePostgreSQL || !d.HasChange("users") {
+		return nil
+	}
+
+	o, n := d.GetChange("users")
+	revoked := o.(*schema.Set).Difference(n.(*schema.Set))
+	if revoked.Len() > 0 {
+		names := make([]string, revoked.Len())
+		for i, v := range revoked.List() {
+			names[i] = v.(map[string]interface{})["name"].(string)
+		}
+		return fmt.Errorf("the privileges of users (%s) cannot be revoked or changed, revoking the privileges "+
+			"is not supported by PostgreSQL instance", strings.Join(names, ", "))
+	}
+	return nil
+}
+
+// updateRdsDatabasePrivilege grants or revokes the privileges of the users in batches.
+func updateRdsDatabasePrivilege(d *schema.ResourceData, meta interface{}, engine string, rawUsers []interface{},
+	revoke bool, timeout time.Duration) error {
+	if len(rawUsers) == 0 {
+		return nil
+	}
+	if revoke && engine == rdsEnginePostgreSQL {
+		return fmt.Errorf("revoking the privileges of the database (%s) is not supported by PostgreSQL instance, "+
+			"please revoke them manually and remove the resource from the state", d.Get("db_name"))
+	}
+
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+	users, err := buildRdsPrivilegeUsers(engine, rawUsers, revoke)
+	if err != nil {
+		return err
+	}
+
+	method := "POST"
+	if revoke {
+		method = "DELETE"
+	}
+	instanceID := d.Get("instance_id").(string)
+	for start := 0; start < len(users); start += rdsPrivilegeBatchSize {
+		end := start + rdsPrivilegeBatchSize
+		if end > len(users) {
+			end = len(users)
+		}
+		body := map[string]interface{}{
+			"db_name": d.Get("db_name").(string),
+			"users":   users[start:end],
+		}
+
+		log.Printf("[DEBUG] %s RDS database privilege options: %#v", method, body)
+		_, err = requestRdsInstanceAPI(client, method, "v3/{project_id}/instances/{instance_id}/db_privilege",
+			instanceID, body, timeout)
+		if err != nil {
+			return fmt.Errorf("error updating the privileges of RDS database (%s): %s", d.Get("db_name"), err)
+		}
+	}
+	return nil
+}
+
+func resourceRdsDatabasePrivilegeCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	engine, err := getRdsInstanceEngine(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting RDS instance (%s): %s", instanceID, err)
+	}
+
+	err = updateRdsDatabasePrivilege(d, meta, engine, d.Get("users").(*schema.Set).List(), false,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, d.Get("db_name").(string)))
+	return resourceRdsDatabasePrivilegeRead(d, meta)
+}
+
+func resourceRdsDatabasePrivilegeRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.RdsV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID, dbName, err := parseRdsResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	engine, err := getRdsInstanceEngine(client, instanceID)
+	if err != nil {
+		return CheckDeleted(d, err, "RDS instance")
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("db_name", dbName)
+	d.Set("engine", engine)
+
+	// The authorized users of the PostgreSQL database cannot be queried, the users in the state are kept.
+	if engine == rdsEnginePostgreSQL {
+		return nil
+	}
+
+	httpUrl := fmt.Sprintf("v3/{project_id}/instances/{instance_id}/database/db_user?db-name=%s", dbName)
+	allUsers, err := listRdsInstanceItems(client, httpUrl, instanceID, "users")
+	if err != nil {
+		return CheckDeleted(d, err, "RDS database privilege")
+	}
+
+	users := make([]map[string]interface{}, len(allUsers))
+	for i, v := range allUsers {
+		users[i] = map[string]interface{}{
+			"name":     utils.PathSearch("name", v, nil),
+			"readonly": utils.PathSearch("readonly", v, false),
+		}
+	}
+	if err := d.Set("users", users); err != nil {
+		return fmt.Errorf("error saving the users of RDS database privilege (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceRdsDatabasePrivilegeUpdate(d *schema.ResourceData, meta interface{}) error {
+	engine := d.Get("engine").(string)
+	o, n := d.GetChange("users")
+	oldUsers, newUsers := o.(*schema.Set), n.(*schema.Set)
+
+	// The privileges of the changed users are granted again after they are revoked.
+	err := updateRdsDatabasePrivilege(d, meta, engine, oldUsers.Difference(newUsers).List(), true,
+		d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	err = updateRdsDatabasePrivilege(d, meta, engine, newUsers.Difference(oldUsers).List(), false,
+		d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRdsDatabasePrivilegeRead(d, meta)
+}
+
+func resourceRdsDatabasePrivilegeDelete(d *schema.ResourceData, meta interface{}) error {
+	return updateRdsDatabasePrivilege(d, meta, d.Get("engine").(string), d.Get("users").(*schema.Set).List(), true,
+		d.Timeout(schema.TimeoutDelete))
+}
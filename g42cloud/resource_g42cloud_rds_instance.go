@@ -1,19 +1,25 @@
 package g42cloud
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/common/tags"
 	"github.com/chnsz/golangsdk/openstack/rds/v3/backups"
 	"github.com/chnsz/golangsdk/openstack/rds/v3/instances"
+	"github.com/chnsz/golangsdk/openstack/rds/v3/securities"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
@@ -21,14 +27,20 @@ import (
 
 func ResourceRdsInstanceV3() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceRdsInstanceV3Create,
-		Read:   resourceRdsInstanceV3Read,
-		Update: resourceRdsInstanceV3Update,
-		Delete: resourceRdsInstanceDelete,
+		CreateContext: resourceRdsInstanceV3Create,
+		Read:          resourceRdsInstanceV3Read,
+		UpdateContext: resourceRdsInstanceV3Update,
+		Delete:        resourceRdsInstanceDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: customdiff.All(
+			validateRdsInstanceFlavor,
+			validateChargingModeChange,
+			validateRdsInstanceAvailabilityZone,
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(30 * time.Minute),
 			Update:  schema.DefaultTimeout(30 * time.Minute),
@@ -47,7 +59,6 @@ func ResourceRdsInstanceV3() *schema.Resource {
 			"availability_zone": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
@@ -76,7 +87,6 @@ func ResourceRdsInstanceV3() *schema.Resource {
 							Type:      schema.TypeString,
 							Sensitive: true,
 							Required:  true,
-							ForceNew:  true,
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -92,12 +102,15 @@ func ResourceRdsInstanceV3() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 						},
 						"user_name": {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"complete_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -109,8 +122,9 @@ func ResourceRdsInstanceV3() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"size": {
-							Type:     schema.TypeInt,
-							Required: true,
+							Type:             schema.TypeInt,
+							Required:         true,
+							DiffSuppressFunc: suppressRdsInstanceAutoscaledSize,
 						},
 						"type": {
 							Type:     schema.TypeString,
@@ -123,6 +137,57 @@ func ResourceRdsInstanceV3() *schema.Resource {
 							Computed: true,
 							ForceNew: true,
 						},
+						"autoscaling": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"limit_size": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(40, 4000),
+									},
+									"trigger_threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntInSlice([]int{10, 15, 20}),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"backup_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"restore.0.backup_id", "restore.0.restore_time"},
+						},
+						"restore_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
 					},
 				},
 			},
@@ -140,7 +205,6 @@ func ResourceRdsInstanceV3() *schema.Resource {
 			"security_group_id": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"backup_strategy": {
@@ -174,7 +238,6 @@ func ResourceRdsInstanceV3() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: utils.ValidateIP,
 			},
 
@@ -182,13 +245,101 @@ func ResourceRdsInstanceV3() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 
 			"param_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
-				ForceNew: true,
+			},
+
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"restart_on_parameter_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"switchover": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"password_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ssl_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+
+			"binlog_retention_hours": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 168),
+			},
+
+			"audit_log": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"keep_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 732),
+						},
+					},
+				},
+			},
+
+			"maintain_begin": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"maintain_end"},
+			},
+
+			"maintain_end": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"maintain_begin"},
+			},
+
+			"upgrade_minor_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"minor_version_auto_upgrade": {
+				Type:     schema.TypeBool,
+				Optional: true,
 			},
 
 			"tags": tagsSchema(),
@@ -255,21 +406,27 @@ func ResourceRdsInstanceV3() *schema.Resource {
 				Computed: true,
 			},
 
-			// charge info: charging_mode, period_unit, period, auto_renew
+			// charge info: charging_mode, period_unit, period, auto_renew, auto_pay
 			"charging_mode": schemaChargingMode(nil),
 			"period_unit":   schemaPeriodUnit(nil),
 			"period":        schemaPeriod(nil),
 			"auto_renew":    schemaAutoRenew(nil),
+			"auto_pay":      schemaAutoPay(nil),
 		},
 	}
 }
 
-func resourceRdsInstanceV3Create(d *schema.ResourceData, meta interface{}) error {
+func resourceRdsInstanceV3Create(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	region := huaweicloud.GetRegion(d, config)
 	client, err := config.RdsV3Client(region)
 	if err != nil {
-		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+		return diag.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	restorePoint, restoreTime, err := buildRdsInstanceRestorePoint(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	createOpts := instances.CreateOpts{
@@ -290,12 +447,13 @@ func resourceRdsInstanceV3Create(d *schema.ResourceData, meta interface{}) error
 		Volume:              buildRdsInstanceVolume(d),
 		BackupStrategy:      buildRdsInstanceBackupStrategy(d),
 		Ha:                  buildRdsInstanceHaReplicationMode(d),
+		RestorePoint:        restorePoint,
 	}
 
 	// PrePaid
 	if d.Get("charging_mode") == "prePaid" {
 		if err := validatePrePaidChargeInfo(d); err != nil {
-			return err
+			return diag.FromErr(err)
 		}
 
 		chargeInfo := &instances.ChargeInfo{
@@ -316,16 +474,16 @@ func resourceRdsInstanceV3Create(d *schema.ResourceData, meta interface{}) error
 	// Add password here so it wouldn't go in the above log entry
 	createOpts.Password = d.Get("db.0.password").(string)
 
-	res, err := instances.Create(client, createOpts).Extract()
+	res, err := instances.Create(client, rdsInstanceCreateOpts{CreateOpts: createOpts, restoreTime: restoreTime}).Extract()
 	if err != nil {
-		return fmt.Errorf("error creating G42Cloud RDS instance: %s", err)
+		return diag.Errorf("error creating G42Cloud RDS instance: %s", err)
 	}
 	d.SetId(res.Instance.Id)
 	instanceID := d.Id()
 
 	if res.JobId != "" {
 		if err := checkRDSInstanceJobFinish(client, res.JobId, d.Timeout(schema.TimeoutCreate)); err != nil {
-			return fmt.Errorf("error creating instance (%s): %s", instanceID, err)
+			return diag.Errorf("error creating instance (%s): %s", instanceID, err)
 		}
 	} else {
 		// for prePaid charge mode
@@ -338,7 +496,7 @@ func resourceRdsInstanceV3Create(d *schema.ResourceData, meta interface{}) error
 			PollInterval: 10 * time.Second,
 		}
 		if _, err = stateConf.WaitForState(); err != nil {
-			return fmt.Errorf("error waiting for RDS instance (%s) creation completed: %s", instanceID, err)
+			return diag.Errorf("error waiting for RDS instance (%s) creation completed: %s", instanceID, err)
 		}
 	}
 
@@ -346,11 +504,24 @@ func resourceRdsInstanceV3Create(d *schema.ResourceData, meta interface{}) error
 	if len(tagRaw) > 0 {
 		taglist := utils.ExpandResourceTags(tagRaw)
 		if tagErr := tags.Create(client, "instances", instanceID, taglist).ExtractErr(); tagErr != nil {
-			return fmt.Errorf("error setting tags of RDS instance (%s): %s", instanceID, tagErr)
+			return diag.Errorf("error setting tags of RDS instance (%s): %s", instanceID, tagErr)
 		}
 	}
 
-	return resourceRdsInstanceV3Read(d, meta)
+	diags, err := updateRdsInstanceParameters(d, client, instanceID)
+	if err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstanceOperationalSettings(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstanceVolumeAutoscaling(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	return append(diags, diag.FromErr(resourceRdsInstanceV3Read(d, meta))...)
 }
 
 func resourceRdsInstanceV3Read(d *schema.ResourceData, meta interface{}) error {
@@ -386,6 +557,12 @@ func resourceRdsInstanceV3Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("time_zone", instance.TimeZone)
 	d.Set("enterprise_project_id", instance.EnterpriseProjectId)
 	d.Set("charging_mode", instance.ChargeInfo.ChargeMode)
+	d.Set("ssl_enable", instance.EnableSsl)
+
+	if maintainWindow := strings.Split(instance.MaintenanceWindow, "-"); len(maintainWindow) == 2 {
+		d.Set("maintain_begin", maintainWindow[0])
+		d.Set("maintain_end", maintainWindow[1])
+	}
 
 	publicIps := make([]interface{}, len(instance.PublicIps))
 	for i, v := range instance.PublicIps {
@@ -406,16 +583,25 @@ func resourceRdsInstanceV3Read(d *schema.ResourceData, meta interface{}) error {
 		"size":               instance.Volume.Size,
 		"disk_encryption_id": instance.DiskEncryptionId,
 	}
+	// The auto-expansion is only queried when it is declared, so that the refresh does not depend on the API.
+	if len(d.Get("volume.0.autoscaling").([]interface{})) > 0 {
+		autoscaling, err := flattenRdsInstanceVolumeAutoscaling(d, client, instanceID)
+		if err != nil {
+			return err
+		}
+		volume[0]["autoscaling"] = autoscaling
+	}
 	if err := d.Set("volume", volume); err != nil {
 		return fmt.Errorf("[DEBUG] Error saving volume to RDS instance (%s): %s", instanceID, err)
 	}
 
 	dbList := make([]map[string]interface{}, 1)
 	database := map[string]interface{}{
-		"type":      instance.DataStore.Type,
-		"version":   instance.DataStore.Version,
-		"port":      instance.Port,
-		"user_name": instance.DbUserName,
+		"type":             instance.DataStore.Type,
+		"version":          instance.DataStore.Version,
+		"port":             instance.Port,
+		"user_name":        instance.DbUserName,
+		"complete_version": instance.DataStore.CompleteVersion,
 	}
 	if len(d.Get("db").([]interface{})) > 0 {
 		database["password"] = d.Get("db.0.password")
@@ -450,30 +636,173 @@ func resourceRdsInstanceV3Read(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("tags", utils.TagsToMap(instance.Tags))
 
-	az1 := instance.Nodes[0].AvailabilityZone
-	if strings.HasSuffix(d.Get("flavor").(string), ".ha") {
-		if len(instance.Nodes) < 2 {
-			return fmt.Errorf("[DEBUG] Error saving availability zone to RDS instance (%s): "+
-				"HA mode must have two availability zone", instanceID)
+	d.Set("availability_zone", flattenRdsInstanceAvailabilityZone(d, instance))
+
+	if err := setRdsInstanceLogPolicies(d, client, instance); err != nil {
+		return err
+	}
+
+	return setRdsInstanceParameters(d, client, instanceID)
+}
+
+// isRdsInstanceArgumentDeclared returns whether the argument is in the configuration or the state, the APIs of the
+// optional settings are only queried for the declared arguments.
+func isRdsInstanceArgumentDeclared(d *schema.ResourceData, key string) bool {
+	raw := d.GetRawConfig()
+	if !raw.IsNull() && raw.IsKnown() && !raw.GetAttr(key).IsNull() {
+		return true
+	}
+	raw = d.GetRawState()
+	return !raw.IsNull() && raw.IsKnown() && !raw.GetAttr(key).IsNull()
+}
+
+// getRdsInstanceSetting queries the setting of the instance once without retrying, nil is returned if the setting is
+// not supported by the instance.
+func getRdsInstanceSetting(client *golangsdk.ServiceClient, httpUrl, instanceID string) (interface{}, error) {
+	path := client.Endpoint + httpUrl
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceID)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", path, &opts)
+	if err != nil {
+		if isRdsInstanceSettingUnsupported(err) {
+			log.Printf("[DEBUG] the setting (%s) is not supported by RDS instance (%s): %s", httpUrl, instanceID, err)
+			return nil, nil
 		}
-		az2 := instance.Nodes[1].AvailabilityZone
-		if instance.Nodes[1].Role == "master" {
-			d.Set("availability_zone", []string{az2, az1})
-		} else {
-			d.Set("availability_zone", []string{az1, az2})
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+// isRdsInstanceSettingUnsupported returns whether the error means the setting is not supported by the instance.
+func isRdsInstanceSettingUnsupported(err error) bool {
+	switch err.(type) {
+	case golangsdk.ErrDefault400, golangsdk.ErrDefault403, golangsdk.ErrDefault404:
+		return true
+	}
+	return false
+}
+
+// setRdsInstanceLogPolicies saves the binlog retention hours of MySQL instance and the audit log policy of MySQL and
+// PostgreSQL instances, they are only queried when they are declared and are left unset if they are not supported.
+func setRdsInstanceLogPolicies(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instance *instances.RdsInstanceResponse) error {
+	engine := strings.ToLower(instance.DataStore.Type)
+	if engine == rdsEngineMySQL && isRdsInstanceArgumentDeclared(d, "binlog_retention_hours") {
+		respBody, err := getRdsInstanceSetting(client, "v3/{project_id}/instances/{instance_id}/binlog/clear-policy",
+			instance.Id)
+		if err != nil {
+			return fmt.Errorf("error getting the binlog retention policy of RDS instance (%s): %s", instance.Id, err)
 		}
-	} else {
-		d.Set("availability_zone", []string{az1})
+		if respBody != nil {
+			d.Set("binlog_retention_hours", utils.PathSearch("binlog_retention_hours", respBody, float64(0)))
+		}
+	}
+
+	if engine != rdsEngineMySQL && engine != rdsEnginePostgreSQL {
+		return nil
+	}
+	if !isRdsInstanceArgumentDeclared(d, "audit_log") {
+		return nil
+	}
+	respBody, err := getRdsInstanceSetting(client, "v3/{project_id}/instances/{instance_id}/auditlog-policy",
+		instance.Id)
+	if err != nil {
+		return fmt.Errorf("error getting the audit log policy of RDS instance (%s): %s", instance.Id, err)
+	}
+	if respBody == nil {
+		return nil
+	}
+	keepDays := int(utils.PathSearch("keep_days", respBody, float64(0)).(float64))
+	auditLog := []map[string]interface{}{
+		{
+			"enabled":   keepDays > 0,
+			"keep_days": keepDays,
+		},
+	}
+	if err := d.Set("audit_log", auditLog); err != nil {
+		return fmt.Errorf("error saving audit log to RDS instance (%s): %s", instance.Id, err)
+	}
+	return nil
+}
+
+// setRdsInstanceParameters saves the current values of the parameters declared in the configuration, the other
+// parameters of the instance are not tracked.
+func setRdsInstanceParameters(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	declared := d.Get("parameters").(*schema.Set).List()
+	if len(declared) == 0 {
+		return nil
+	}
+
+	configs, err := instances.GetConfigurations(client, instanceID).Extract()
+	if err != nil {
+		return fmt.Errorf("error getting the parameters of RDS instance (%s): %s", instanceID, err)
+	}
+	values := make(map[string]string, len(configs.Parameters))
+	for _, v := range configs.Parameters {
+		values[v.Name] = v.Value
 	}
 
+	params := make([]map[string]interface{}, 0, len(declared))
+	for _, v := range declared {
+		name := v.(map[string]interface{})["name"].(string)
+		if value, ok := values[name]; ok {
+			params = append(params, map[string]interface{}{
+				"name":  name,
+				"value": value,
+			})
+		}
+	}
+	if err := d.Set("parameters", params); err != nil {
+		return fmt.Errorf("error saving parameters to RDS instance (%s): %s", instanceID, err)
+	}
 	return nil
 }
 
-func resourceRdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error {
+// getRdsInstanceNodeAvailabilityZones returns the AZs of the primary and standby nodes by their roles, the standby AZ
+// is empty for a single instance.
+func getRdsInstanceNodeAvailabilityZones(instance *instances.RdsInstanceResponse) (primary, standby string) {
+	for _, node := range instance.Nodes {
+		switch node.Role {
+		case "master":
+			primary = node.AvailabilityZone
+		case "slave":
+			standby = node.AvailabilityZone
+		}
+	}
+	if primary == "" && len(instance.Nodes) > 0 {
+		primary = instance.Nodes[0].AvailabilityZone
+	}
+	return
+}
+
+// flattenRdsInstanceAvailabilityZone returns the AZs of the primary and standby nodes, the order in the state is kept
+// if the AZs are not changed, so that a switchover does not make a difference.
+func flattenRdsInstanceAvailabilityZone(d *schema.ResourceData, instance *instances.RdsInstanceResponse) []string {
+	current := utils.ExpandToStringList(d.Get("availability_zone").([]interface{}))
+	primary, standby := getRdsInstanceNodeAvailabilityZones(instance)
+	if primary == "" {
+		// the node list is empty, keep the AZs as they are
+		return current
+	}
+	if standby == "" {
+		return []string{primary}
+	}
+
+	if len(current) == 2 && current[0] == standby && current[1] == primary {
+		return current
+	}
+	return []string{primary, standby}
+}
+
+func resourceRdsInstanceV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	client, err := config.RdsV3Client(huaweicloud.GetRegion(d, config))
 	if err != nil {
-		return fmt.Errorf("error creating G42Cloud RDS Client: %s", err)
+		return diag.Errorf("error creating G42Cloud RDS Client: %s", err)
 	}
 	instanceID := d.Id()
 	// Since the instance will throw an exception when making an API interface call in 'BACKING UP' state,
@@ -487,33 +816,96 @@ func resourceRdsInstanceV3Update(d *schema.ResourceData, meta interface{}) error
 		MinTimeout: 3 * time.Second,
 	}
 	if _, err = stateConf.WaitForState(); err != nil {
-		return fmt.Errorf("error waiting for RDS instance (%s) become active state: %s", instanceID, err)
+		return diag.Errorf("error waiting for RDS instance (%s) become active state: %s", instanceID, err)
 	}
 
 	if err := updateRdsInstanceName(d, client, instanceID); err != nil {
-		return fmt.Errorf("[ERROR] %s", err)
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstancePassword(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstanceSingleToHa(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
 	}
 
 	if err := updateRdsInstanceFlavor(d, client, instanceID); err != nil {
-		return fmt.Errorf("[ERROR] %s", err)
+		return diag.Errorf("[ERROR] %s", err)
 	}
 
 	if err := updateRdsInstanceVolumeSize(d, client, instanceID); err != nil {
-		return fmt.Errorf("[ERROR] %s", err)
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstanceVolumeAutoscaling(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
 	}
 
 	if err := updateRdsInstanceBackpStrategy(d, client, instanceID); err != nil {
-		return fmt.Errorf("[ERROR] %s", err)
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstanceSecurityGroup(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstanceDBPort(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstanceFixedIp(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	diags, err := updateRdsInstanceParameterGroup(d, client, instanceID)
+	if err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	warnings, err := updateRdsInstanceParameters(d, client, instanceID)
+	if err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+	diags = append(diags, warnings...)
+
+	if err := updateRdsInstanceOperationalSettings(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := upgradeRdsInstanceMinorVersion(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstanceStandbyAvailabilityZone(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := updateRdsInstanceReplicationMode(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if err := switchoverRdsInstance(d, client, instanceID); err != nil {
+		return diag.Errorf("[ERROR] %s", err)
+	}
+
+	if d.HasChange("charging_mode") {
+		if err := convertChargingMode(ctx, d, config, convertRdsInstanceToPrePaid); err != nil {
+			return diag.Errorf("error updating the charging mode of RDS instance (%s): %s", instanceID, err)
+		}
+	} else if err := updatePrePaidChargeInfo(ctx, d, config, instanceID); err != nil {
+		return diag.Errorf("error updating the charging info of RDS instance (%s): %s", instanceID, err)
 	}
 
 	if d.HasChange("tags") {
 		tagErr := utils.UpdateResourceTags(client, d, "instances", instanceID)
 		if tagErr != nil {
-			return fmt.Errorf("error updating tags of RDS instance (%s): %s", instanceID, tagErr)
+			return diag.Errorf("error updating tags of RDS instance (%s): %s", instanceID, tagErr)
 		}
 	}
 
-	return resourceRdsInstanceV3Read(d, meta)
+	return append(diags, diag.FromErr(resourceRdsInstanceV3Read(d, meta))...)
 }
 
 func resourceRdsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -526,7 +918,7 @@ func resourceRdsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 	id := d.Id()
 	log.Printf("[DEBUG] Deleting Instance %s", id)
 	if v, ok := d.GetOk("charging_mode"); ok && v.(string) == "prePaid" {
-		if err := huaweicloud.UnsubscribePrePaidResource(d, config, []string{id}); err != nil {
+		if err := UnsubscribePrePaidResource(d, config, []string{id}); err != nil {
 			return fmt.Errorf("error unsubscribe G42Cloud RDS instance: %s", err)
 		}
 	} else {
@@ -540,7 +932,7 @@ func resourceRdsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
 		Pending:    []string{"ACTIVE"},
 		Target:     []string{"DELETED"},
 		Refresh:    rdsInstanceStateRefreshFunc(client, id),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
 		Delay:      15 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
@@ -633,6 +1025,51 @@ func buildRdsInstanceBackupStrategy(d *schema.ResourceData) *instances.BackupStr
 	return backupStrategy
 }
 
+// rdsInstanceCreateOpts sends the restore time of the point-in-time recovery as a number, which is expected by the
+// RDS API.
+type rdsInstanceCreateOpts struct {
+	instances.CreateOpts
+	restoreTime int64
+}
+
+func (opts rdsInstanceCreateOpts) ToInstancesCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToInstancesCreateMap()
+	if err != nil || opts.restoreTime == 0 {
+		return b, err
+	}
+
+	if restorePoint, ok := b["restore_point"].(map[string]interface{}); ok {
+		restorePoint["restore_time"] = opts.restoreTime
+	}
+	return b, nil
+}
+
+// buildRdsInstanceRestorePoint returns the restore point and the restore time in milliseconds if the instance is
+// restored from a backup or a point in time of the source instance.
+func buildRdsInstanceRestorePoint(d *schema.ResourceData) (*instances.RestorePoint, int64, error) {
+	restoreRaw := d.Get("restore").([]interface{})
+	if len(restoreRaw) == 0 || restoreRaw[0] == nil {
+		return nil, 0, nil
+	}
+
+	raw := restoreRaw[0].(map[string]interface{})
+	restorePoint := instances.RestorePoint{
+		InstanceId: raw["source_instance_id"].(string),
+	}
+	if backupID := raw["backup_id"].(string); backupID != "" {
+		restorePoint.Type = "backup"
+		restorePoint.BackupId = backupID
+		return &restorePoint, 0, nil
+	}
+
+	restoreTime, err := time.Parse(time.RFC3339, raw["restore_time"].(string))
+	if err != nil {
+		return nil, 0, fmt.Errorf("error parsing the restore time: %s", err)
+	}
+	restorePoint.Type = "timestamp"
+	return &restorePoint, restoreTime.UnixMilli(), nil
+}
+
 func buildRdsInstanceHaReplicationMode(d *schema.ResourceData) *instances.Ha {
 	var ha *instances.Ha
 	if v, ok := d.GetOk("ha_replication_mode"); ok {
@@ -671,18 +1108,55 @@ func updateRdsInstanceName(d *schema.ResourceData, client *golangsdk.ServiceClie
 	return nil
 }
 
+func updateRdsInstancePassword(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if !d.HasChanges("db.0.password", "password_version") {
+		return nil
+	}
+
+	resetOpts := instances.RestRootPasswordOpts{
+		DbUserPwd: d.Get("db.0.password").(string),
+	}
+	_, err := instances.RestRootPassword(client, instanceID, resetOpts)
+	if err != nil {
+		return fmt.Errorf("error resetting the password of RDS instance (%s): %s", instanceID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"MODIFYING", "BACKING UP"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    rdsInstanceStateRefreshFunc(client, instanceID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RDS instance (%s) password to be reset: %s", instanceID, err)
+	}
+
+	return nil
+}
+
 func updateRdsInstanceFlavor(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
 	if !d.HasChange("flavor") {
 		return nil
 	}
 
+	// the flavor may have been changed by the conversion from single to primary/standby
+	instance, err := getRdsInstanceByID(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting RDS instance (%s): %s", instanceID, err)
+	}
+	if instance.FlavorRef == d.Get("flavor").(string) {
+		return nil
+	}
+
 	resizeFlavor := instances.SpecCode{
 		Speccode: d.Get("flavor").(string),
 	}
 	var resizeFlavorOpts instances.ResizeFlavorOpts
 	resizeFlavorOpts.ResizeFlavor = &resizeFlavor
 
-	_, err := instances.Resize(client, resizeFlavorOpts, instanceID).Extract()
+	_, err = instances.Resize(client, resizeFlavorOpts, instanceID).Extract()
 	if err != nil {
 		return fmt.Errorf("error updating instance Flavor from result: %s ", err)
 	}
@@ -726,6 +1200,93 @@ func updateRdsInstanceVolumeSize(d *schema.ResourceData, client *golangsdk.Servi
 	return nil
 }
 
+// updateRdsInstanceVolumeAutoscaling enables or disables the storage auto-expansion of the instance.
+func updateRdsInstanceVolumeAutoscaling(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	if !d.HasChange("volume.0.autoscaling") {
+		return nil
+	}
+
+	oldEnabled, newEnabled := d.GetChange("volume.0.autoscaling.0.enabled")
+	if !newEnabled.(bool) {
+		if !oldEnabled.(bool) {
+			return nil
+		}
+		log.Printf("[DEBUG] Disable the storage auto-expansion of RDS instance (%s)", instanceID)
+		if err := instances.DisableAutoExpand(client, instanceID); err != nil {
+			return fmt.Errorf("error disabling the storage auto-expansion of RDS instance (%s): %s", instanceID, err)
+		}
+		return nil
+	}
+
+	if d.Get("volume.0.autoscaling.0.limit_size").(int) == 0 ||
+		d.Get("volume.0.autoscaling.0.trigger_threshold").(int) == 0 {
+		return fmt.Errorf("the limit_size and trigger_threshold must be specified to enable the storage auto-expansion")
+	}
+	opts := instances.EnableAutoExpandOpts{
+		InstanceId:       instanceID,
+		LimitSize:        d.Get("volume.0.autoscaling.0.limit_size").(int),
+		TriggerThreshold: d.Get("volume.0.autoscaling.0.trigger_threshold").(int),
+	}
+	log.Printf("[DEBUG] Enable the storage auto-expansion opts: %+v", opts)
+	if err := instances.EnableAutoExpand(client, opts); err != nil {
+		return fmt.Errorf("error enabling the storage auto-expansion of RDS instance (%s): %s", instanceID, err)
+	}
+	return nil
+}
+
+// flattenRdsInstanceVolumeAutoscaling returns the storage auto-expansion configuration, the block is omitted if the
+// auto-expansion is disabled and it is not declared in the configuration.
+func flattenRdsInstanceVolumeAutoscaling(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) ([]map[string]interface{}, error) {
+	autoExpansion, err := instances.GetAutoExpand(client, instanceID)
+	if err != nil {
+		if isRdsInstanceSettingUnsupported(err) {
+			log.Printf("[DEBUG] the storage auto-expansion is not supported by RDS instance (%s): %s", instanceID, err)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting the storage auto-expansion of RDS instance (%s): %s", instanceID, err)
+	}
+
+	if autoExpansion.SwitchOption {
+		return []map[string]interface{}{
+			{
+				"enabled":           true,
+				"limit_size":        autoExpansion.LimitSize,
+				"trigger_threshold": autoExpansion.TriggerThreshold,
+			},
+		}, nil
+	}
+	if len(d.Get("volume.0.autoscaling").([]interface{})) == 0 {
+		return nil, nil
+	}
+	// The limit size and trigger threshold are meaningless when the auto-expansion is disabled, keep them unchanged.
+	return []map[string]interface{}{
+		{
+			"enabled":           false,
+			"limit_size":        d.Get("volume.0.autoscaling.0.limit_size"),
+			"trigger_threshold": d.Get("volume.0.autoscaling.0.trigger_threshold"),
+		},
+	}, nil
+}
+
+// suppressRdsInstanceAutoscaledSize ignores the difference when the volume size is automatically expanded beyond the
+// configuration.
+func suppressRdsInstanceAutoscaledSize(_, oldValue, newValue string, d *schema.ResourceData) bool {
+	if !d.Get("volume.0.autoscaling.0.enabled").(bool) {
+		return false
+	}
+	oldSize, err := strconv.Atoi(oldValue)
+	if err != nil {
+		return false
+	}
+	newSize, err := strconv.Atoi(newValue)
+	if err != nil {
+		return false
+	}
+	return newSize < oldSize
+}
+
 func updateRdsInstanceBackpStrategy(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
 	if !d.HasChange("backup_strategy") {
 		return nil
@@ -762,6 +1323,537 @@ func updateRdsInstanceBackpStrategy(d *schema.ResourceData, client *golangsdk.Se
 	return nil
 }
 
+func updateRdsInstanceSecurityGroup(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if !d.HasChange("security_group_id") {
+		return nil
+	}
+
+	updateOpts := securities.SecGroupOpts{
+		SecurityGroupId: d.Get("security_group_id").(string),
+	}
+	log.Printf("[DEBUG] Update opts of security group: %+v", updateOpts)
+	_, err := securities.UpdateSecGroup(client, instanceID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating the security group of RDS instance (%s): %s", instanceID, err)
+	}
+
+	return waitForRdsInstanceActive(d, client, instanceID)
+}
+
+func updateRdsInstanceDBPort(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if !d.HasChange("db.0.port") {
+		return nil
+	}
+
+	updateOpts := securities.PortOpts{
+		Port: d.Get("db.0.port").(int),
+	}
+	log.Printf("[DEBUG] Update opts of database port: %+v", updateOpts)
+	job, err := securities.UpdatePort(client, instanceID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating the database port of RDS instance (%s): %s", instanceID, err)
+	}
+	if err := waitForRdsInstanceJobCompleted(client, job.WorkflowId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating instance (%s): %s", instanceID, err)
+	}
+
+	return waitForRdsInstanceActive(d, client, instanceID)
+}
+
+func updateRdsInstanceFixedIp(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if !d.HasChange("fixed_ip") {
+		return nil
+	}
+
+	updateOpts := securities.DataIpOpts{
+		NewIp: d.Get("fixed_ip").(string),
+	}
+	log.Printf("[DEBUG] Update opts of fixed IP: %+v", updateOpts)
+	job, err := securities.UpdateDataIp(client, instanceID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("error updating the fixed IP of RDS instance (%s): %s", instanceID, err)
+	}
+	if err := waitForRdsInstanceJobCompleted(client, job.WorkflowId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating instance (%s): %s", instanceID, err)
+	}
+
+	return waitForRdsInstanceActive(d, client, instanceID)
+}
+
+// updateRdsInstanceParameterGroup applies the parameter group to the instance, the instance is rebooted only when
+// the parameters require a restart and restart_on_parameter_change is enabled, otherwise a warning is returned.
+func updateRdsInstanceParameterGroup(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) (diag.Diagnostics, error) {
+	if !d.HasChange("param_group_id") {
+		return nil, nil
+	}
+
+	configID := d.Get("param_group_id").(string)
+	if configID == "" {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "The parameter group of the RDS instance cannot be removed",
+				Detail: fmt.Sprintf("The parameter group of RDS instance (%s) cannot be removed, it is kept on the "+
+					"instance.", instanceID),
+			},
+		}, nil
+	}
+
+	httpUrl := fmt.Sprintf("v3/{project_id}/configurations/%s/apply", configID)
+	path := client.Endpoint + strings.ReplaceAll(httpUrl, "{project_id}", client.ProjectID)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody: map[string]interface{}{
+			"instance_ids": []string{instanceID},
+		},
+		OkCodes: []int{200, 202},
+	}
+	resp, err := client.Request("PUT", path, &opts)
+	if err != nil {
+		return nil, fmt.Errorf("error applying parameter group (%s) to RDS instance (%s): %s", configID, instanceID, err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	applyResult := utils.PathSearch(fmt.Sprintf("apply_results[?instance_id=='%s']|[0]", instanceID),
+		respBody, nil)
+	if applyResult == nil {
+		return nil, fmt.Errorf("unable to find the result of applying parameter group to RDS instance (%s)", instanceID)
+	}
+	if !utils.PathSearch("success", applyResult, false).(bool) {
+		return nil, fmt.Errorf("failed to apply parameter group (%s) to RDS instance (%s)", configID, instanceID)
+	}
+	if err := waitForRdsInstanceActive(d, client, instanceID); err != nil {
+		return nil, err
+	}
+
+	if !utils.PathSearch("restart_required", applyResult, false).(bool) {
+		return nil, nil
+	}
+	return restartRdsInstanceForParameters(d, client, instanceID, "the parameter group")
+}
+
+// updateRdsInstanceParameters modifies the parameters which are added or changed in the configuration, the parameters
+// removed from the configuration are kept on the instance with their current values.
+func updateRdsInstanceParameters(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) (diag.Diagnostics, error) {
+	if !d.HasChange("parameters") {
+		return nil, nil
+	}
+
+	o, n := d.GetChange("parameters")
+	changed := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+	if len(changed) == 0 {
+		return nil, nil
+	}
+	values := make(map[string]string, len(changed))
+	for _, v := range changed {
+		raw := v.(map[string]interface{})
+		values[raw["name"].(string)] = raw["value"].(string)
+	}
+
+	opts := instances.ModifyConfigurationOpts{
+		Values: values,
+	}
+	log.Printf("[DEBUG] Modify RDS instance parameters options: %#v", opts)
+	res, err := instances.ModifyConfiguration(client, instanceID, opts).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error modifying the parameters of RDS instance (%s): %s", instanceID, err)
+	}
+	if res.JobId != "" {
+		if err := waitForRdsInstanceJobCompleted(client, res.JobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return nil, fmt.Errorf("error waiting for the parameters of RDS instance (%s) to be modified: %s",
+				instanceID, err)
+		}
+	}
+	if err := waitForRdsInstanceActive(d, client, instanceID); err != nil {
+		return nil, err
+	}
+
+	if !res.Restart {
+		return nil, nil
+	}
+	configs, err := instances.GetConfigurations(client, instanceID).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("error getting the parameters of RDS instance (%s): %s", instanceID, err)
+	}
+	restartParams := make([]string, 0)
+	for _, v := range configs.Parameters {
+		if _, ok := values[v.Name]; ok && v.Restart {
+			restartParams = append(restartParams, v.Name)
+		}
+	}
+	sort.Strings(restartParams)
+	return restartRdsInstanceForParameters(d, client, instanceID,
+		fmt.Sprintf("the parameters [%s]", strings.Join(restartParams, ", ")))
+}
+
+// restartRdsInstanceForParameters reboots the instance if restart_on_parameter_change is enabled, otherwise a warning
+// is returned to remind that the instance should be restarted manually.
+func restartRdsInstanceForParameters(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID,
+	target string) (diag.Diagnostics, error) {
+	if !d.Get("restart_on_parameter_change").(bool) {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "The RDS instance needs to be restarted",
+				Detail: fmt.Sprintf("RDS instance (%s) needs to be restarted for %s to take effect, please restart "+
+					"it manually or enable restart_on_parameter_change.", instanceID, target),
+			},
+		}, nil
+	}
+
+	log.Printf("[DEBUG] Rebooting RDS instance (%s) for %s to take effect", instanceID, target)
+	if _, err := instances.RebootInstance(client, instanceID).Extract(); err != nil {
+		return nil, fmt.Errorf("error rebooting RDS instance (%s): %s", instanceID, err)
+	}
+	return nil, waitForRdsInstanceActive(d, client, instanceID)
+}
+
+// updateRdsInstanceOperationalSettings updates the SSL, binlog retention hours, audit log policy, automatic minor
+// version upgrade and maintenance window of the instance.
+func updateRdsInstanceOperationalSettings(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	engine := strings.ToLower(d.Get("db.0.type").(string))
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChange("ssl_enable") {
+		if engine != rdsEngineMySQL {
+			return fmt.Errorf("only MySQL instance supports enabling and disabling SSL")
+		}
+		sslEnable := d.Get("ssl_enable").(bool)
+		opts := securities.SSLOpts{
+			SSLEnable: &sslEnable,
+		}
+		if err := securities.UpdateSSL(client, instanceID, opts).ExtractErr(); err != nil {
+			return fmt.Errorf("error updating the SSL of RDS instance (%s): %s", instanceID, err)
+		}
+		if err := waitForRdsInstanceActive(d, client, instanceID); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("binlog_retention_hours") {
+		if engine != rdsEngineMySQL {
+			return fmt.Errorf("only MySQL instance supports setting the binlog retention hours")
+		}
+		body := map[string]interface{}{
+			"binlog_retention_hours": d.Get("binlog_retention_hours").(int),
+		}
+		_, err := requestRdsInstanceAPI(client, "PUT", "v3/{project_id}/instances/{instance_id}/binlog/clear-policy",
+			instanceID, body, timeout)
+		if err != nil {
+			return fmt.Errorf("error updating the binlog retention hours of RDS instance (%s): %s", instanceID, err)
+		}
+	}
+
+	if d.HasChange("audit_log") && len(d.Get("audit_log").([]interface{})) > 0 {
+		if engine != rdsEngineMySQL && engine != rdsEnginePostgreSQL {
+			return fmt.Errorf("only MySQL and PostgreSQL instances support the audit log")
+		}
+		keepDays := 0
+		if d.Get("audit_log.0.enabled").(bool) {
+			keepDays = d.Get("audit_log.0.keep_days").(int)
+			if keepDays == 0 {
+				keepDays = 7
+			}
+		}
+		body := map[string]interface{}{
+			"keep_days": keepDays,
+		}
+		if keepDays == 0 {
+			body["reserve_auditlogs"] = true
+		}
+		_, err := requestRdsInstanceAPI(client, "PUT", "v3/{project_id}/instances/{instance_id}/auditlog-policy",
+			instanceID, body, timeout)
+		if err != nil {
+			return fmt.Errorf("error updating the audit log policy of RDS instance (%s): %s", instanceID, err)
+		}
+	}
+
+	if d.HasChange("minor_version_auto_upgrade") {
+		if engine != rdsEngineMySQL {
+			return fmt.Errorf("only MySQL instance supports the automatic minor version upgrade")
+		}
+		body := map[string]interface{}{
+			"is_auto_upgrade": d.Get("minor_version_auto_upgrade").(bool),
+		}
+		_, err := requestRdsInstanceAPI(client, "PUT", "v3/{project_id}/instances/{instance_id}/db-auto-upgrade",
+			instanceID, body, timeout)
+		if err != nil {
+			return fmt.Errorf("error updating the automatic minor version upgrade of RDS instance (%s): %s",
+				instanceID, err)
+		}
+	}
+
+	if d.HasChanges("maintain_begin", "maintain_end") {
+		body := map[string]interface{}{
+			"start_time": d.Get("maintain_begin").(string),
+			"end_time":   d.Get("maintain_end").(string),
+		}
+		_, err := requestRdsInstanceAPI(client, "PUT", "v3/{project_id}/instances/{instance_id}/ops-window",
+			instanceID, body, timeout)
+		if err != nil {
+			return fmt.Errorf("error updating the maintenance window of RDS instance (%s): %s", instanceID, err)
+		}
+	}
+
+	return nil
+}
+
+// upgradeRdsInstanceMinorVersion upgrades the minor version of MySQL instance immediately when upgrade_minor_version
+// is changed to true.
+func upgradeRdsInstanceMinorVersion(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if !d.HasChange("upgrade_minor_version") || !d.Get("upgrade_minor_version").(bool) {
+		return nil
+	}
+	if strings.ToLower(d.Get("db.0.type").(string)) != rdsEngineMySQL {
+		return fmt.Errorf("only MySQL instance supports upgrading the minor version")
+	}
+
+	httpUrl := fmt.Sprintf("v3/{project_id}/instances/%s/db-upgrade", instanceID)
+	jobID, err := doRdsInstanceWorkflowAction(client, "POST", httpUrl, map[string]interface{}{
+		"is_delayed": false,
+	})
+	if err != nil {
+		return fmt.Errorf("error upgrading the minor version of RDS instance (%s): %s", instanceID, err)
+	}
+	if err := waitForRdsInstanceJobCompleted(client, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating instance (%s): %s", instanceID, err)
+	}
+
+	return waitForRdsInstanceActive(d, client, instanceID)
+}
+
+// validateRdsInstanceAvailabilityZone checks the AZ changes during the plan, only adding the AZ of the standby node to
+// a single instance and migrating the standby node to another AZ can be done in place.
+func validateRdsInstanceAvailabilityZone(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("availability_zone") || !d.NewValueKnown("availability_zone") {
+		return nil
+	}
+
+	o, n := d.GetChange("availability_zone")
+	oldAzs := utils.ExpandToStringList(o.([]interface{}))
+	newAzs := utils.ExpandToStringList(n.([]interface{}))
+	if len(oldAzs) == 0 || len(newAzs) == 0 {
+		return nil
+	}
+	if len(newAzs) != 2 {
+		return fmt.Errorf("a primary/standby instance cannot be changed to a single instance")
+	}
+	if len(oldAzs) == 1 {
+		if newAzs[0] != oldAzs[0] {
+			return fmt.Errorf("the AZ of the primary node cannot be changed, the AZ of the standby node can only " +
+				"be added as the second element of `availability_zone`")
+		}
+		if !strings.HasSuffix(d.Get("flavor").(string), ".ha") {
+			return fmt.Errorf("the flavor must be changed to a primary/standby flavor (*.ha) when adding the AZ " +
+				"of the standby node")
+		}
+		return nil
+	}
+	// the order of the AZs is kept after a switchover, the changed AZ is checked against the node roles during
+	// the apply
+	if oldAzs[0] != newAzs[0] && oldAzs[1] != newAzs[1] {
+		return fmt.Errorf("the AZ of the primary node cannot be changed, only the AZ of the standby node can be " +
+			"changed in place")
+	}
+	return nil
+}
+
+// updateRdsInstanceSingleToHa converts a single instance to a primary/standby instance when the AZ of the standby node
+// is added.
+func updateRdsInstanceSingleToHa(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	o, n := d.GetChange("availability_zone")
+	if len(o.([]interface{})) != 1 || len(n.([]interface{})) != 2 {
+		return nil
+	}
+
+	singleToHaOpts := instances.SingleToHaRdsOpts{
+		SingleToHa: &instances.SingleToHaRds{
+			AzCodeNewNode: d.Get("availability_zone.1").(string),
+		},
+	}
+	if strings.EqualFold(d.Get("db.0.type").(string), "SQLServer") {
+		singleToHaOpts.SingleToHa.Password = d.Get("db.0.password").(string)
+	}
+
+	log.Printf("[DEBUG] Single to HA opts of RDS instance (%s): %s", instanceID,
+		singleToHaOpts.SingleToHa.AzCodeNewNode)
+	resp, err := instances.SingleToHa(client, singleToHaOpts, instanceID).Extract()
+	if err != nil {
+		return fmt.Errorf("error converting RDS instance (%s) to primary/standby: %s", instanceID, err)
+	}
+	if err := waitForRdsInstanceJobCompleted(client, resp.JobId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating instance (%s): %s", instanceID, err)
+	}
+
+	return waitForRdsInstanceActive(d, client, instanceID)
+}
+
+// updateRdsInstanceStandbyAvailabilityZone migrates the standby node to the new AZ, the standby node is found by its
+// role since the order of `availability_zone` is kept after a switchover.
+func updateRdsInstanceStandbyAvailabilityZone(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	o, n := d.GetChange("availability_zone")
+	oldAzs := utils.ExpandToStringList(o.([]interface{}))
+	newAzs := utils.ExpandToStringList(n.([]interface{}))
+	if len(oldAzs) != 2 || len(newAzs) != 2 {
+		return nil
+	}
+	changed := -1
+	for i := range newAzs {
+		if oldAzs[i] != newAzs[i] {
+			changed = i
+		}
+	}
+	if changed < 0 {
+		return nil
+	}
+
+	instance, err := getRdsInstanceByID(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting RDS instance (%s): %s", instanceID, err)
+	}
+	var nodeID string
+	for _, node := range instance.Nodes {
+		if node.Role == "slave" {
+			nodeID = node.Id
+		}
+	}
+	if nodeID == "" {
+		return fmt.Errorf("unable to find the standby node of RDS instance (%s)", instanceID)
+	}
+	// the unchanged AZ must be the AZ of the primary node
+	if primary, _ := getRdsInstanceNodeAvailabilityZones(instance); newAzs[1-changed] != primary {
+		return fmt.Errorf("the AZ of the primary node (%s) of RDS instance (%s) cannot be changed, only the AZ of "+
+			"the standby node can be changed", primary, instanceID)
+	}
+
+	jobID, err := migrateRdsInstanceStandbyNode(client, instanceID, nodeID, newAzs[changed])
+	if err != nil {
+		return fmt.Errorf("error migrating the standby node of RDS instance (%s): %s", instanceID, err)
+	}
+	if err := waitForRdsInstanceJobCompleted(client, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating instance (%s): %s", instanceID, err)
+	}
+
+	return waitForRdsInstanceActive(d, client, instanceID)
+}
+
+// migrateRdsInstanceStandbyNode migrates the standby node to the AZ and returns the ID of the workflow.
+func migrateRdsInstanceStandbyNode(client *golangsdk.ServiceClient, instanceID, nodeID, azCode string) (string,
+	error) {
+	body := map[string]interface{}{
+		"nodeId": nodeID,
+		"azCode": azCode,
+	}
+	httpUrl := fmt.Sprintf("v3/{project_id}/instances/%s/migrateslave", instanceID)
+	return doRdsInstanceWorkflowAction(client, "POST", httpUrl, body)
+}
+
+func updateRdsInstanceReplicationMode(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	// the replication mode is kept as it is when it is removed from the configuration
+	if !d.HasChange("ha_replication_mode") || d.Get("ha_replication_mode").(string) == "" {
+		return nil
+	}
+
+	body := map[string]interface{}{
+		"mode": d.Get("ha_replication_mode").(string),
+	}
+	httpUrl := fmt.Sprintf("v3/{project_id}/instances/%s/failover/mode", instanceID)
+	jobID, err := doRdsInstanceWorkflowAction(client, "PUT", httpUrl, body)
+	if err != nil {
+		return fmt.Errorf("error updating the replication mode of RDS instance (%s): %s", instanceID, err)
+	}
+	if err := waitForRdsInstanceJobCompleted(client, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating instance (%s): %s", instanceID, err)
+	}
+
+	return waitForRdsInstanceActive(d, client, instanceID)
+}
+
+// switchoverRdsInstance performs a manual switchover between the primary and standby nodes when the switchover is
+// changed to a new non-empty value, removing it does not trigger a switchover.
+func switchoverRdsInstance(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if !d.HasChange("switchover") || d.Get("switchover").(string) == "" {
+		return nil
+	}
+
+	httpUrl := fmt.Sprintf("v3/{project_id}/instances/%s/failover", instanceID)
+	jobID, err := doRdsInstanceWorkflowAction(client, "PUT", httpUrl, map[string]interface{}{})
+	if err != nil {
+		return fmt.Errorf("error switching over RDS instance (%s): %s", instanceID, err)
+	}
+	if err := waitForRdsInstanceJobCompleted(client, jobID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("error updating instance (%s): %s", instanceID, err)
+	}
+
+	return waitForRdsInstanceActive(d, client, instanceID)
+}
+
+// doRdsInstanceWorkflowAction sends the request of the instance action and returns the ID of its workflow.
+func doRdsInstanceWorkflowAction(client *golangsdk.ServiceClient, method, httpUrl string,
+	body map[string]interface{}) (string, error) {
+	path := client.Endpoint + strings.ReplaceAll(httpUrl, "{project_id}", client.ProjectID)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		JSONBody:         body,
+		OkCodes:          []int{200, 202},
+	}
+
+	log.Printf("[DEBUG] RDS instance action (%s %s) options: %#v", method, httpUrl, body)
+	resp, err := client.Request(method, path, &opts)
+	if err != nil {
+		return "", err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	jobID := utils.PathSearch("workflowId || workflow_id || job_id", respBody, "").(string)
+	if jobID == "" {
+		return "", fmt.Errorf("the workflow ID is not found in the response")
+	}
+	return jobID, nil
+}
+
+func waitForRdsInstanceActive(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"MODIFYING", "MODIFYING DATABASE PORT", "REBOOTING", "BACKING UP"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    rdsInstanceStateRefreshFunc(client, instanceID),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RDS instance (%s) become active state: %s", instanceID, err)
+	}
+	return nil
+}
+
+// convertRdsInstanceToPrePaid changes a postPaid RDS instance to prePaid.
+func convertRdsInstanceToPrePaid(d *schema.ResourceData, config *config.Config) (string, error) {
+	client, err := config.NewServiceClient("rds", GetRegion(d, config))
+	if err != nil {
+		return "", fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	body := map[string]interface{}{
+		"period_type":   strings.ToUpper(d.Get("period_unit").(string)),
+		"period_num":    d.Get("period").(int),
+		"is_auto_renew": d.Get("auto_renew").(string) == "true",
+		"auto_pay":      true,
+	}
+	return doChargingModeChange(client, fmt.Sprintf("v3/{project_id}/instances/%s/to-period", d.Id()), body)
+}
+
 func checkRDSInstanceJobFinish(client *golangsdk.ServiceClient, jobID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{"Running"},
@@ -777,6 +1869,26 @@ func checkRDSInstanceJobFinish(client *golangsdk.ServiceClient, jobID string, ti
 	return nil
 }
 
+// waitForRdsInstanceJobCompleted waits for the RDS job to be completed, an error is returned if the job fails.
+func waitForRdsInstanceJobCompleted(client *golangsdk.ServiceClient, jobID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Running"},
+		Target:       []string{"Completed"},
+		Refresh:      rdsInstanceJobRefreshFunc(client, jobID),
+		Timeout:      timeout,
+		Delay:        20 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	job, err := stateConf.WaitForState()
+	if err != nil {
+		if j, ok := job.(instances.Job); ok && j.Status == "Failed" {
+			return fmt.Errorf("the RDS job (%s) failed: %s", jobID, j.FailReason)
+		}
+		return fmt.Errorf("error waiting for RDS job (%s) to be completed: %s", jobID, err)
+	}
+	return nil
+}
+
 func rdsInstanceJobRefreshFunc(client *golangsdk.ServiceClient, jobID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		jobOpts := instances.RDSJobOpts{
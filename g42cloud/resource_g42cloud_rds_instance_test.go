@@ -1,13 +1,20 @@
 package g42cloud
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/rds/v3/instances"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 )
@@ -36,19 +43,52 @@ func TestAccRdsInstanceV3_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "time_zone", "UTC+08:00"),
 					resource.TestCheckResourceAttr(resourceName, "fixed_ip", "192.168.0.58"),
 					resource.TestCheckResourceAttr(resourceName, "charging_mode", "postPaid"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						"name":  "log_min_duration_statement",
+						"value": "1000",
+					}),
 				),
 			},
 			{
-				Config: testAccRdsInstanceV3_update(name),
+				Config: testAccRdsInstanceV3_update(name, "Huangwei!120521", ""),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckRdsInstanceV3Exists(resourceName, &instance),
 					resource.TestCheckResourceAttr(resourceName, "name", name),
 					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.keep_days", "2"),
 					resource.TestCheckResourceAttr(resourceName, "flavor", "rds.pg.c6.xlarge.4"),
 					resource.TestCheckResourceAttr(resourceName, "volume.0.size", "100"),
+					resource.TestCheckResourceAttr(resourceName, "volume.0.autoscaling.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "volume.0.autoscaling.0.limit_size", "200"),
+					resource.TestCheckResourceAttr(resourceName, "volume.0.autoscaling.0.trigger_threshold", "10"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value"),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar_updated"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_ip", "192.168.0.68"),
+					resource.TestCheckResourceAttr(resourceName, "db.0.port", "8636"),
 					resource.TestCheckResourceAttr(resourceName, "charging_mode", "postPaid"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						"name":  "log_min_duration_statement",
+						"value": "2000",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						"name":  "max_connections",
+						"value": "200",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "audit_log.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "audit_log.0.keep_days", "5"),
+					resource.TestCheckResourceAttr(resourceName, "maintain_begin", "06:00"),
+					resource.TestCheckResourceAttr(resourceName, "maintain_end", "09:00"),
+				),
+			},
+			{
+				// rotate the password of the administrator
+				Config: testAccRdsInstanceV3_update(name, "Huangwei!120522", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "db.0.password", "Huangwei!120522"),
+					resource.TestCheckResourceAttr(resourceName, "password_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
 				),
 			},
 			{
@@ -58,6 +98,11 @@ func TestAccRdsInstanceV3_basic(t *testing.T) {
 				ImportStateVerifyIgnore: []string{
 					"db",
 					"status",
+					"parameters",
+					"restart_on_parameter_change",
+					"password_version",
+					"audit_log",
+					"volume.0.autoscaling",
 				},
 			},
 		},
@@ -116,6 +161,98 @@ func TestAccRdsInstanceV3_ha(t *testing.T) {
 	})
 }
 
+func TestAccRdsInstanceV3_singleToHa(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceType := "g42cloud_rds_instance"
+	resourceName := "g42cloud_rds_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsInstanceV3Destroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstanceV3_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone.#", "1"),
+				),
+			},
+			{
+				Config: testAccRdsInstanceV3_singleToHa(name, "async", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "rds.pg.c6.large.4.ha"),
+					resource.TestCheckResourceAttr(resourceName, "availability_zone.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "nodes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ha_replication_mode", "async"),
+				),
+			},
+			{
+				Config: testAccRdsInstanceV3_singleToHa(name, "sync", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "ha_replication_mode", "sync"),
+					resource.TestCheckResourceAttr(resourceName, "switchover", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRdsInstanceV3_restoreFromBackup(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceType := "g42cloud_rds_instance"
+	resourceName := "g42cloud_rds_instance.restored"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsInstanceV3Destroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstanceV3_restoreFromBackup(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-restored"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.source_instance_id",
+						"g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.backup_id",
+						"g42cloud_rds_backup.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRdsInstanceV3_restoreToPointInTime(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceType := "g42cloud_rds_instance"
+	resourceName := "g42cloud_rds_instance.restored"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckRdsInstanceV3Destroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstanceV3_restoreToPointInTime(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceV3Exists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-restored"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.restore_time",
+						"data.g42cloud_rds_restore_time_ranges.test", "restore_time_ranges.0.end_time"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRdsInstanceV3Destroy(rsType string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		config := testAccProvider.Meta().(*config.Config)
@@ -226,6 +363,11 @@ resource "g42cloud_rds_instance" "test" {
     keep_days  = 1
   }
 
+  parameters {
+    name  = "log_min_duration_statement"
+    value = "1000"
+  }
+
   tags = {
     key = "value"
     foo = "bar"
@@ -234,8 +376,9 @@ resource "g42cloud_rds_instance" "test" {
 `, testAccRdsInstanceV3_base(name), name)
 }
 
-// volume.size, backup_strategy, flavor and tags will be updated
-func testAccRdsInstanceV3_update(name string) string {
+// volume.size, volume.autoscaling, backup_strategy, flavor, fixed_ip, db.port, parameters, audit_log, maintenance
+// window and tags will be updated, the password is reset when the password or password_version is changed
+func testAccRdsInstanceV3_update(name, password, passwordVersion string) string {
 	return fmt.Sprintf(`
 %s
 
@@ -247,28 +390,54 @@ resource "g42cloud_rds_instance" "test" {
   subnet_id         = g42cloud_vpc_subnet.test.id
   vpc_id            = g42cloud_vpc.test.id
   time_zone         = "UTC+08:00"
+  fixed_ip          = "192.168.0.68"
+  password_version  = "%s"
 
   db {
-    password = "Huangwei!120521"
+    password = "%s"
     type     = "PostgreSQL"
     version  = "11"
-    port     = 8635
+    port     = 8636
   }
   volume {
     type = "ULTRAHIGH"
     size = 100
+
+    autoscaling {
+      enabled           = true
+      limit_size        = 200
+      trigger_threshold = 10
+    }
   }
   backup_strategy {
     start_time = "09:00-10:00"
     keep_days  = 2
   }
 
+  maintain_begin              = "06:00"
+  maintain_end                = "09:00"
+  restart_on_parameter_change = true
+
+  audit_log {
+    enabled   = true
+    keep_days = 5
+  }
+
+  parameters {
+    name  = "log_min_duration_statement"
+    value = "2000"
+  }
+  parameters {
+    name  = "max_connections"
+    value = "200"
+  }
+
   tags = {
     key1 = "value"
     foo  = "bar_updated"
   }
 }
-`, testAccRdsInstanceV3_base(name), name)
+`, testAccRdsInstanceV3_base(name), name, passwordVersion, password)
 }
 
 func testAccRdsInstanceV3_epsId(name string) string {
@@ -342,3 +511,207 @@ resource "g42cloud_rds_instance" "test" {
 }
 `, testAccRdsInstanceV3_base(name), name)
 }
+
+func testAccRdsInstanceV3_singleToHa(name, replicationMode, switchover string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_rds_instance" "test" {
+  name                = "%s"
+  flavor              = "rds.pg.c6.large.4.ha"
+  security_group_id   = g42cloud_networking_secgroup.test.id
+  subnet_id           = g42cloud_vpc_subnet.test.id
+  vpc_id              = g42cloud_vpc.test.id
+  time_zone           = "UTC+08:00"
+  fixed_ip            = "192.168.0.58"
+  ha_replication_mode = "%s"
+  switchover          = "%s"
+  availability_zone   = [
+    data.g42cloud_availability_zones.test.names[0],
+    data.g42cloud_availability_zones.test.names[1],
+  ]
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "11"
+    port     = 8635
+  }
+  volume {
+    type = "ULTRAHIGH"
+    size = 50
+  }
+  backup_strategy {
+    start_time = "08:00-09:00"
+    keep_days  = 1
+  }
+
+  tags = {
+    key = "value"
+    foo = "bar"
+  }
+}
+`, testAccRdsInstanceV3_base(name), name, replicationMode, switchover)
+}
+
+// the full backup of the source instance is required by both of the backup and the point-in-time restorations
+func testAccRdsInstanceV3_restoreBase(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_rds_backup" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+  name        = "%s"
+}
+`, testAccRdsInstanceV3_basic(name), name)
+}
+
+func testAccRdsInstanceV3_restoreFromBackup(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_rds_instance" "restored" {
+  name              = "%s-restored"
+  flavor            = "rds.pg.c6.large.4"
+  availability_zone = [data.g42cloud_availability_zones.test.names[0]]
+  security_group_id = g42cloud_networking_secgroup.test.id
+  subnet_id         = g42cloud_vpc_subnet.test.id
+  vpc_id            = g42cloud_vpc.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "11"
+    port     = 8635
+  }
+  volume {
+    type = "ULTRAHIGH"
+    size = 50
+  }
+
+  restore {
+    source_instance_id = g42cloud_rds_instance.test.id
+    backup_id          = g42cloud_rds_backup.test.id
+  }
+}
+`, testAccRdsInstanceV3_restoreBase(name), name)
+}
+
+func testAccRdsInstanceV3_restoreToPointInTime(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_rds_restore_time_ranges" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+
+  depends_on = [g42cloud_rds_backup.test]
+}
+
+resource "g42cloud_rds_instance" "restored" {
+  name              = "%s-restored"
+  flavor            = "rds.pg.c6.large.4"
+  availability_zone = [data.g42cloud_availability_zones.test.names[0]]
+  security_group_id = g42cloud_networking_secgroup.test.id
+  subnet_id         = g42cloud_vpc_subnet.test.id
+  vpc_id            = g42cloud_vpc.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "11"
+    port     = 8635
+  }
+  volume {
+    type = "ULTRAHIGH"
+    size = 50
+  }
+
+  restore {
+    source_instance_id = g42cloud_rds_instance.test.id
+    restore_time       = data.g42cloud_rds_restore_time_ranges.test.restore_time_ranges[0].end_time
+  }
+}
+`, testAccRdsInstanceV3_restoreBase(name), name)
+}
+
+func TestRestartRdsInstanceForParameters(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceRdsInstanceV3().Schema, map[string]interface{}{})
+	diags, err := restartRdsInstanceForParameters(d, nil, "instance-id", "the parameters [max_connections]")
+	if err != nil {
+		t.Fatalf("restartRdsInstanceForParameters returns error: %s", err)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("restartRdsInstanceForParameters returns %v, expected a warning", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "max_connections") {
+		t.Errorf("the warning (%s) does not contain the parameters", diags[0].Detail)
+	}
+}
+
+func TestGetRdsInstanceSetting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/binlog/clear-policy"):
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error_code":"DBS.200611","error_msg":"The operation is not supported."}`)
+		case strings.HasSuffix(r.URL.Path, "/auditlog-policy"):
+			fmt.Fprint(w, `{"keep_days":5}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-id"},
+		Endpoint:       server.URL + "/",
+	}
+
+	respBody, err := getRdsInstanceSetting(client, "v3/{project_id}/instances/{instance_id}/binlog/clear-policy",
+		"instance-id")
+	if err != nil || respBody != nil {
+		t.Errorf("the unsupported setting returns %v and error %v, expected nil", respBody, err)
+	}
+	respBody, err = getRdsInstanceSetting(client, "v3/{project_id}/instances/{instance_id}/auditlog-policy",
+		"instance-id")
+	if err != nil {
+		t.Fatalf("getRdsInstanceSetting returns error: %s", err)
+	}
+	if keepDays, _ := respBody.(map[string]interface{})["keep_days"].(float64); keepDays != 5 {
+		t.Errorf("the keep_days is %v, expected 5", respBody)
+	}
+	if _, err = getRdsInstanceSetting(client, "v3/{project_id}/instances/{instance_id}/ops-window",
+		"instance-id"); err == nil {
+		t.Errorf("the server error is expected to be returned")
+	}
+}
+
+func TestMigrateRdsInstanceStandbyNode(t *testing.T) {
+	var reqPath string
+	var reqBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reqPath = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
+			t.Errorf("failed to decode the request body: %s", err)
+		}
+		fmt.Fprint(w, `{"workflowId":"job-id"}`)
+	}))
+	defer server.Close()
+	client := &golangsdk.ServiceClient{
+		ProviderClient: &golangsdk.ProviderClient{ProjectID: "project-id"},
+		Endpoint:       server.URL + "/",
+	}
+
+	jobID, err := migrateRdsInstanceStandbyNode(client, "instance-id", "node-id", "az-2")
+	if err != nil {
+		t.Fatalf("migrateRdsInstanceStandbyNode returns error: %s", err)
+	}
+	if jobID != "job-id" {
+		t.Errorf("the job ID is %s, expected job-id", jobID)
+	}
+	if expected := "/v3/project-id/instances/instance-id/migrateslave"; reqPath != expected {
+		t.Errorf("the request path is %s, expected %s", reqPath, expected)
+	}
+	if len(reqBody) != 2 || reqBody["nodeId"] != "node-id" || reqBody["azCode"] != "az-2" {
+		t.Errorf("the request body is %v, expected nodeId and azCode", reqBody)
+	}
+}
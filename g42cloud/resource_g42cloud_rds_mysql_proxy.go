@@ -0,0 +1,345 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsMysqlProxy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsMysqlProxyCreate,
+		Read:   resourceRdsMysqlProxyRead,
+		Update: resourceRdsMysqlProxyUpdate,
+		Delete: resourceRdsMysqlProxyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"flavor": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"node_num": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"proxy_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"delay_threshold_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 7200),
+			},
+			"master_node_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+			"readonly_nodes_weight": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"proxy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// listRdsMysqlProxies queries all the proxies of the instance.
+func listRdsMysqlProxies(client *golangsdk.ServiceClient, instanceID string, timeout time.Duration) ([]interface{},
+	error) {
+	respBody, err := requestRdsInstanceAPI(client, "GET", "v3/{project_id}/instances/{instance_id}/proxies",
+		instanceID, nil, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return utils.PathSearch("proxy_query_info_list", respBody, make([]interface{}, 0)).([]interface{}), nil
+}
+
+// getRdsMysqlProxy queries the proxy by its ID, a 404 error is returned if it is not found.
+func getRdsMysqlProxy(client *golangsdk.ServiceClient, instanceID, proxyID string,
+	timeout time.Duration) (interface{}, error) {
+	proxies, err := listRdsMysqlProxies(client, instanceID, timeout)
+	if err != nil {
+		return nil, err
+	}
+	proxy := utils.PathSearch(fmt.Sprintf("[?proxy.pool_id=='%s']|[0]", proxyID), proxies, nil)
+	if proxy == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return proxy, nil
+}
+
+// doRdsMysqlProxyAction sends the request of the proxy and waits for the job to be completed if it is returned.
+func doRdsMysqlProxyAction(d *schema.ResourceData, client *golangsdk.ServiceClient, method, httpUrl string,
+	body map[string]interface{}, timeout time.Duration) error {
+	instanceID := d.Get("instance_id").(string)
+	log.Printf("[DEBUG] RDS MySQL proxy action (%s %s) options: %#v", method, httpUrl, body)
+	respBody, err := requestRdsInstanceAPI(client, method, httpUrl, instanceID, body, timeout)
+	if err != nil {
+		return err
+	}
+
+	jobID := utils.PathSearch("workflow_id || workflowId || job_id", respBody, "").(string)
+	if jobID == "" {
+		return nil
+	}
+	return waitForRdsInstanceJobCompleted(client, jobID, timeout)
+}
+
+func resourceRdsMysqlProxyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	engine, err := getRdsInstanceEngine(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting RDS instance (%s): %s", instanceID, err)
+	}
+	if engine != rdsEngineMySQL {
+		return fmt.Errorf("the proxy can only be enabled for the MySQL instance, but got %s", engine)
+	}
+
+	// The proxy ID is not returned by the API, find the new one from the proxies of the instance.
+	timeout := d.Timeout(schema.TimeoutCreate)
+	oldProxies, err := listRdsMysqlProxies(client, instanceID, timeout)
+	if err != nil {
+		return fmt.Errorf("error querying the proxies of RDS instance (%s): %s", instanceID, err)
+	}
+
+	body := map[string]interface{}{
+		"flavor_ref": d.Get("flavor").(string),
+		"node_num":   d.Get("node_num").(int),
+		"proxy_name": utils.ValueIngoreEmpty(d.Get("proxy_name")),
+		"subnet_id":  utils.ValueIngoreEmpty(d.Get("subnet_id")),
+	}
+	err = doRdsMysqlProxyAction(d, client, "POST", "v3/{project_id}/instances/{instance_id}/proxy",
+		utils.RemoveNil(body), timeout)
+	if err != nil {
+		return fmt.Errorf("error creating RDS MySQL proxy: %s", err)
+	}
+
+	newProxies, err := listRdsMysqlProxies(client, instanceID, timeout)
+	if err != nil {
+		return fmt.Errorf("error querying the proxies of RDS instance (%s): %s", instanceID, err)
+	}
+	for _, v := range newProxies {
+		proxyID := utils.PathSearch("proxy.pool_id", v, "").(string)
+		if proxyID == "" {
+			continue
+		}
+		if utils.PathSearch(fmt.Sprintf("[?proxy.pool_id=='%s']|[0]", proxyID), oldProxies, nil) == nil {
+			d.SetId(fmt.Sprintf("%s/%s", instanceID, proxyID))
+			break
+		}
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("unable to find the created proxy of RDS instance (%s)", instanceID)
+	}
+
+	if err := updateRdsMysqlProxy(d, client, timeout); err != nil {
+		return err
+	}
+	return resourceRdsMysqlProxyRead(d, meta)
+}
+
+func resourceRdsMysqlProxyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.RdsV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID, proxyID, err := parseRdsResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	proxy, err := getRdsMysqlProxy(client, instanceID, proxyID, d.Timeout(schema.TimeoutDefault))
+	if err != nil {
+		return CheckDeleted(d, err, "RDS MySQL proxy")
+	}
+
+	readonlyNodes := make([]map[string]interface{}, 0)
+	for _, v := range utils.PathSearch("readonly_instances", proxy, make([]interface{}, 0)).([]interface{}) {
+		readonlyNodes = append(readonlyNodes, map[string]interface{}{
+			"id":     utils.PathSearch("id", v, nil),
+			"weight": utils.PathSearch("weight", v, nil),
+		})
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("proxy_id", proxyID)
+	d.Set("flavor", utils.PathSearch("proxy.flavor_info.code", proxy, nil))
+	d.Set("node_num", utils.PathSearch("proxy.node_num", proxy, nil))
+	d.Set("proxy_name", utils.PathSearch("proxy.name", proxy, nil))
+	d.Set("subnet_id", utils.PathSearch("proxy.subnet_id", proxy, nil))
+	d.Set("delay_threshold_in_seconds", utils.PathSearch("proxy.delay_threshold_in_seconds", proxy, nil))
+	d.Set("master_node_weight", utils.PathSearch("master_instance.weight", proxy, nil))
+	d.Set("address", utils.PathSearch("proxy.address", proxy, nil))
+	d.Set("port", utils.PathSearch("proxy.port", proxy, nil))
+	d.Set("status", utils.PathSearch("proxy.status", proxy, nil))
+	if err := d.Set("readonly_nodes_weight", readonlyNodes); err != nil {
+		return fmt.Errorf("error saving the read-only nodes weight of RDS MySQL proxy (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+// buildRdsMysqlProxyWeightBody builds the request body of the read weights. The weights are computed, only the
+// configured weights are sent so that the others are kept unchanged.
+func buildRdsMysqlProxyWeightBody(d *schema.ResourceData) map[string]interface{} {
+	body := make(map[string]interface{})
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return body
+	}
+
+	if !rawConfig.GetAttr("master_node_weight").IsNull() {
+		body["master_weight"] = strconv.Itoa(d.Get("master_node_weight").(int))
+	}
+	if rawNodes := rawConfig.GetAttr("readonly_nodes_weight"); !rawNodes.IsNull() && rawNodes.LengthInt() > 0 {
+		readonlyNodes := make([]map[string]interface{}, 0)
+		for _, v := range d.Get("readonly_nodes_weight").(*schema.Set).List() {
+			raw := v.(map[string]interface{})
+			readonlyNodes = append(readonlyNodes, map[string]interface{}{
+				"id":     raw["id"],
+				"weight": raw["weight"],
+			})
+		}
+		body["readonly_instances"] = readonlyNodes
+	}
+	return body
+}
+
+// updateRdsMysqlProxy updates the delay threshold and the read weights of the proxy.
+func updateRdsMysqlProxy(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) error {
+	_, proxyID, err := parseRdsResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("delay_threshold_in_seconds") {
+		body := map[string]interface{}{
+			"delay_threshold_in_seconds": d.Get("delay_threshold_in_seconds").(int),
+		}
+		httpUrl := fmt.Sprintf("v3/{project_id}/instances/{instance_id}/proxy/%s/delay-threshold", proxyID)
+		if err := doRdsMysqlProxyAction(d, client, "PUT", httpUrl, body, timeout); err != nil {
+			return fmt.Errorf("error updating the delay threshold of RDS MySQL proxy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if body := buildRdsMysqlProxyWeightBody(d); len(body) > 0 && d.HasChanges("master_node_weight",
+		"readonly_nodes_weight") {
+		httpUrl := fmt.Sprintf("v3/{project_id}/instances/{instance_id}/proxy/%s/weight", proxyID)
+		if err := doRdsMysqlProxyAction(d, client, "PUT", httpUrl, body, timeout); err != nil {
+			return fmt.Errorf("error updating the read weights of RDS MySQL proxy (%s): %s", d.Id(), err)
+		}
+	}
+
+	return nil
+}
+
+func resourceRdsMysqlProxyUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	if err := updateRdsMysqlProxy(d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return err
+	}
+	return resourceRdsMysqlProxyRead(d, meta)
+}
+
+func resourceRdsMysqlProxyDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	body := map[string]interface{}{
+		"proxy_ids": []string{d.Get("proxy_id").(string)},
+	}
+	err = doRdsMysqlProxyAction(d, client, "DELETE", "v3/{project_id}/instances/{instance_id}/proxy", body,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "RDS MySQL proxy")
+	}
+	return nil
+}
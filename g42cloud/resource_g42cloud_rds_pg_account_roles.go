@@ -0,0 +1,168 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsPgAccountRoles() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsPgAccountRolesCreate,
+		Read:   resourceRdsPgAccountRolesRead,
+		Update: resourceRdsPgAccountRolesUpdate,
+		Delete: resourceRdsPgAccountRolesDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// updateRdsPgAccountRoles grants the roles to the account or revokes the roles from the account.
+func updateRdsPgAccountRoles(d *schema.ResourceData, meta interface{}, roles []interface{}, revoke bool,
+	timeout time.Duration) error {
+	if len(roles) == 0 {
+		return nil
+	}
+
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	method := "POST"
+	if revoke {
+		method = "DELETE"
+	}
+	body := map[string]interface{}{
+		"user":  d.Get("user").(string),
+		"roles": roles,
+	}
+	log.Printf("[DEBUG] %s RDS PostgreSQL account roles options: %#v", method, body)
+	_, err = requestRdsInstanceAPI(client, method, "v3/{project_id}/instances/{instance_id}/db-user-role",
+		d.Get("instance_id").(string), body, timeout)
+	if err != nil {
+		return fmt.Errorf("error updating the roles of RDS PostgreSQL account (%s): %s", d.Get("user"), err)
+	}
+	return nil
+}
+
+func resourceRdsPgAccountRolesCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	engine, err := getRdsInstanceEngine(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting RDS instance (%s): %s", instanceID, err)
+	}
+	if engine != rdsEnginePostgreSQL {
+		return fmt.Errorf("the account roles can only be granted on the PostgreSQL instance, but got %s", engine)
+	}
+
+	err = updateRdsPgAccountRoles(d, meta, d.Get("roles").(*schema.Set).List(), false, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, d.Get("user").(string)))
+	return resourceRdsPgAccountRolesRead(d, meta)
+}
+
+func resourceRdsPgAccountRolesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.RdsV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID, userName, err := parseRdsResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	if _, err := getRdsInstanceEngine(client, instanceID); err != nil {
+		return CheckDeleted(d, err, "RDS instance")
+	}
+
+	users, err := listRdsInstanceItems(client, "v3/{project_id}/instances/{instance_id}/db_user/detail",
+		instanceID, "users")
+	if err != nil {
+		return fmt.Errorf("error querying the accounts of RDS instance (%s): %s", instanceID, err)
+	}
+	user := utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", userName), users, nil)
+	if user == nil {
+		log.Printf("[WARN] the RDS PostgreSQL account (%s) is not found, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("user", userName)
+	d.Set("roles", utils.PathSearch("memberof", user, nil))
+
+	return nil
+}
+
+func resourceRdsPgAccountRolesUpdate(d *schema.ResourceData, meta interface{}) error {
+	o, n := d.GetChange("roles")
+	oldRoles, newRoles := o.(*schema.Set), n.(*schema.Set)
+
+	err := updateRdsPgAccountRoles(d, meta, oldRoles.Difference(newRoles).List(), true,
+		d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	err = updateRdsPgAccountRoles(d, meta, newRoles.Difference(oldRoles).List(), false,
+		d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceRdsPgAccountRolesRead(d, meta)
+}
+
+func resourceRdsPgAccountRolesDelete(d *schema.ResourceData, meta interface{}) error {
+	return updateRdsPgAccountRoles(d, meta, d.Get("roles").(*schema.Set).List(), true,
+		d.Timeout(schema.TimeoutDelete))
+}
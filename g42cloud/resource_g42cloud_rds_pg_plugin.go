@@ -0,0 +1,238 @@
+package g42cloud
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceRdsPgPlugin() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRdsPgPluginCreate,
+		Read:   resourceRdsPgPluginRead,
+		Delete: resourceRdsPgPluginDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"database_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared_preload_libraries": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// parseRdsPgPluginID splits the ID in the format of <instance_id>/<database_name>/<name>.
+func parseRdsPgPluginID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("invalid ID format (%s), must be <instance_id>/<database_name>/<name>", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// getRdsPgPlugin queries the extension of the database, a 404 error is returned if it is not found.
+func getRdsPgPlugin(client *golangsdk.ServiceClient, instanceID, dbName, name string) (interface{}, error) {
+	path := client.Endpoint + "v3/{project_id}/instances/{instance_id}/extensions"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceID)
+	opts := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+
+	for offset := 0; ; offset += 100 {
+		resp, err := client.Request("GET", fmt.Sprintf("%s?database_name=%s&offset=%d&limit=100", path, dbName, offset),
+			&opts)
+		if err != nil {
+			return nil, err
+		}
+		respBody, err := utils.FlattenResponse(resp)
+		if err != nil {
+			return nil, err
+		}
+
+		extensions := utils.PathSearch("extensions", respBody, make([]interface{}, 0)).([]interface{})
+		extension := utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", name), extensions, nil)
+		if extension != nil {
+			return extension, nil
+		}
+		if len(extensions) < 100 {
+			return nil, golangsdk.ErrDefault404{}
+		}
+	}
+}
+
+func rdsPgPluginStateRefreshFunc(client *golangsdk.ServiceClient, instanceID, dbName,
+	name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		extension, err := getRdsPgPlugin(client, instanceID, dbName, name)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return name, "DELETED", nil
+			}
+			return nil, "ERROR", err
+		}
+		if utils.PathSearch("created", extension, false).(bool) {
+			return extension, "CREATED", nil
+		}
+		return extension, "DELETED", nil
+	}
+}
+
+func resourceRdsPgPluginCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	engine, err := getRdsInstanceEngine(client, instanceID)
+	if err != nil {
+		return fmt.Errorf("error getting RDS instance (%s): %s", instanceID, err)
+	}
+	if engine != rdsEnginePostgreSQL {
+		return fmt.Errorf("the plugin can only be installed on the PostgreSQL instance, but got %s", engine)
+	}
+
+	dbName := d.Get("database_name").(string)
+	name := d.Get("name").(string)
+	body := map[string]interface{}{
+		"database_name":  dbName,
+		"extension_name": name,
+	}
+	log.Printf("[DEBUG] Create RDS PostgreSQL plugin options: %#v", body)
+	_, err = requestRdsInstanceAPI(client, "POST", "v3/{project_id}/instances/{instance_id}/extensions", instanceID,
+		body, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("error creating RDS PostgreSQL plugin: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, dbName, name))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"DELETED"},
+		Target:       []string{"CREATED"},
+		Refresh:      rdsPgPluginStateRefreshFunc(client, instanceID, dbName, name),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RDS PostgreSQL plugin (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceRdsPgPluginRead(d, meta)
+}
+
+func resourceRdsPgPluginRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := GetRegion(d, config)
+	client, err := config.RdsV3Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID, dbName, name, err := parseRdsPgPluginID(d.Id())
+	if err != nil {
+		return err
+	}
+	extension, err := getRdsPgPlugin(client, instanceID, dbName, name)
+	if err != nil {
+		return CheckDeleted(d, err, "RDS PostgreSQL plugin")
+	}
+	if !utils.PathSearch("created", extension, false).(bool) {
+		log.Printf("[WARN] the RDS PostgreSQL plugin (%s) is not installed, removing it from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("region", region)
+	d.Set("instance_id", instanceID)
+	d.Set("database_name", dbName)
+	d.Set("name", name)
+	d.Set("version", utils.PathSearch("version", extension, nil))
+	d.Set("shared_preload_libraries", utils.PathSearch("shared_preload_libraries", extension, nil))
+	d.Set("description", utils.PathSearch("description", extension, nil))
+
+	return nil
+}
+
+func resourceRdsPgPluginDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.RdsV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating G42Cloud RDS client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	dbName := d.Get("database_name").(string)
+	name := d.Get("name").(string)
+	body := map[string]interface{}{
+		"database_name":  dbName,
+		"extension_name": name,
+	}
+	_, err = requestRdsInstanceAPI(client, "DELETE", "v3/{project_id}/instances/{instance_id}/extensions",
+		instanceID, body, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return CheckDeleted(d, err, "RDS PostgreSQL plugin")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATED"},
+		Target:       []string{"DELETED"},
+		Refresh:      rdsPgPluginStateRefreshFunc(client, instanceID, dbName, name),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RDS PostgreSQL plugin (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -20,7 +20,6 @@ func schemaChargingMode(conflicts []string) *schema.Schema {
 	resourceSchema := schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
-		ForceNew: true,
 		Computed: true,
 		ValidateFunc: validation.StringInSlice([]string{
 			"prePaid", "postPaid",
@@ -35,7 +34,6 @@ func schemaPeriodUnit(conflicts []string) *schema.Schema {
 	resourceSchema := schema.Schema{
 		Type:         schema.TypeString,
 		Optional:     true,
-		ForceNew:     true,
 		RequiredWith: []string{"period"},
 		ValidateFunc: validation.StringInSlice([]string{
 			"month", "year",
@@ -50,7 +48,6 @@ func schemaPeriod(conflicts []string) *schema.Schema {
 	resourceSchema := schema.Schema{
 		Type:          schema.TypeInt,
 		Optional:      true,
-		ForceNew:      true,
 		RequiredWith:  []string{"period_unit"},
 		ValidateFunc:  validation.IntBetween(1, 9),
 		ConflictsWith: conflicts,
@@ -63,7 +60,6 @@ func schemaAutoRenew(conflicts []string) *schema.Schema {
 	resourceSchema := schema.Schema{
 		Type:     schema.TypeString,
 		Optional: true,
-		ForceNew: true,
 		ValidateFunc: validation.StringInSlice([]string{
 			"true", "false",
 		}, false),
@@ -0,0 +1,43 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccDmsKafkaConnectionInfoDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceNameWithDash()
+	dataSourceName := "data.g42cloud_dms_kafka_connection_info.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaConnectionInfoDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "ssl_enable", "true"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "private_bootstrap_servers"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_bootstrap_servers", ""),
+					resource.TestCheckResourceAttrSet(dataSourceName, "ca_certificate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaConnectionInfoDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_dms_kafka_connection_info" "test" {
+  instance_id = g42cloud_dms_kafka_instance.test.id
+}
+`, testAccKafkaInstance_basic(rName))
+}
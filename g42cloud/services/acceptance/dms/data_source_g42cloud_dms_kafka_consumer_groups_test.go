@@ -0,0 +1,45 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccDmsKafkaConsumerGroupsDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceNameWithDash()
+	dataSourceName := "data.g42cloud_dms_kafka_consumer_groups.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaConsumerGroupsDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.description", "created by terraform"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.state", "EMPTY"),
+					resource.TestCheckResourceAttr(dataSourceName, "groups.0.lag", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaConsumerGroupsDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_dms_kafka_consumer_groups" "test" {
+  instance_id = g42cloud_dms_kafka_instance.test.id
+  name        = g42cloud_dms_kafka_consumer_group.test.name
+}
+`, testAccDmsKafkaConsumerGroup_basic(rName, "created by terraform"))
+}
@@ -0,0 +1,50 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccDmsKafkaTopicsDataSource_basic(t *testing.T) {
+	rName := acceptance.RandomAccResourceNameWithDash()
+	dataSourceName := "data.g42cloud_dms_kafka_topics.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaTopicsDataSource_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "topics.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "topics.0.name",
+						"g42cloud_dms_kafka_topic.topic", "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "topics.0.partitions",
+						"g42cloud_dms_kafka_topic.topic", "partitions"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "topics.0.replicas",
+						"g42cloud_dms_kafka_topic.topic", "replicas"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "topics.0.aging_time",
+						"g42cloud_dms_kafka_topic.topic", "aging_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "max_partitions"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaTopicsDataSource_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_dms_kafka_topics" "test" {
+  instance_id = g42cloud_dms_kafka_instance.test.id
+  name        = g42cloud_dms_kafka_topic.topic.name
+}
+`, testAccDmsKafkaTopic_basic(rName))
+}
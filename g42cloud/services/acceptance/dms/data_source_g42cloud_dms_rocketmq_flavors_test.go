@@ -0,0 +1,49 @@
+package dms
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccDataSourceDmsRocketMQFlavors_basic(t *testing.T) {
+	dataSourceName := "data.g42cloud_dms_rocketmq_flavors.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceDmsRocketMQFlavors_basic,
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "flavors.0.type", "cluster"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "flavors.0.ios.0.availability_zones.0",
+						"data.g42cloud_dms_rocketmq_availability_zones.test", "codes.0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "flavors.0.properties.0.max_broker"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "flavors.0.properties.0.min_storage_per_node"),
+					resource.TestCheckResourceAttr("data.g42cloud_dms_rocketmq_flavors.filter_by_id", "flavors.#", "1"),
+					resource.TestCheckResourceAttrPair("data.g42cloud_dms_rocketmq_flavors.filter_by_id",
+						"flavors.0.id", dataSourceName, "flavors.0.id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceDmsRocketMQFlavors_basic = `
+data "g42cloud_dms_rocketmq_availability_zones" "test" {}
+
+data "g42cloud_dms_rocketmq_flavors" "test" {
+  type               = "cluster"
+  availability_zones = [data.g42cloud_dms_rocketmq_availability_zones.test.codes[0]]
+}
+
+data "g42cloud_dms_rocketmq_flavors" "filter_by_id" {
+  flavor_id = data.g42cloud_dms_rocketmq_flavors.test.flavors[0].id
+}
+`
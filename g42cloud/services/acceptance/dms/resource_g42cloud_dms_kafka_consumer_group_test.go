@@ -0,0 +1,146 @@
+package dms
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDmsKafkaConsumerGroupFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.G42_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceID := state.Primary.Attributes["instance_id"]
+	name := state.Primary.Attributes["name"]
+	getPath := client.Endpoint + "v2/{project_id}/instances/{instance_id}/groups"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{instance_id}", instanceID)
+	getPath += "?group=" + url.QueryEscape(name)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	group := utils.PathSearch(fmt.Sprintf("groups[?group_id=='%s']|[0]", name), respBody, nil)
+	if group == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return group, nil
+}
+
+func TestAccDmsKafkaConsumerGroup_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "g42cloud_dms_kafka_consumer_group.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsKafkaConsumerGroupFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaConsumerGroup_basic(rName, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by terraform"),
+					resource.TestCheckResourceAttr(resourceName, "state", "EMPTY"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				Config: testAccDmsKafkaConsumerGroup_basic(rName, "updated by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated by terraform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDmsKafkaConsumerGroup_offsetReset(t *testing.T) {
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "g42cloud_dms_kafka_consumer_group_offset_reset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaConsumerGroup_offsetReset(rName, "earliest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reset_to", "earliest"),
+					resource.TestCheckResourceAttr(resourceName, "partition", "-1"),
+				),
+			},
+			{
+				Config: testAccDmsKafkaConsumerGroup_offsetReset(rName, "latest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "reset_to", "latest"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaConsumerGroup_basic(rName, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_kafka_consumer_group" "test" {
+  instance_id = g42cloud_dms_kafka_instance.test.id
+  name        = "%s"
+  description = "%s"
+}
+`, testAccKafkaInstance_basic(rName), rName, description)
+}
+
+func testAccDmsKafkaConsumerGroup_offsetReset(rName, resetTo string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_kafka_consumer_group" "test" {
+  instance_id = g42cloud_dms_kafka_instance.test.id
+  name        = "%s"
+}
+
+resource "g42cloud_dms_kafka_consumer_group_offset_reset" "test" {
+  instance_id = g42cloud_dms_kafka_instance.test.id
+  group       = g42cloud_dms_kafka_consumer_group.test.name
+  topic       = g42cloud_dms_kafka_topic.topic.name
+  reset_to    = "%s"
+}
+`, testAccDmsKafkaTopic_basic(rName), rName, resetTo)
+}
@@ -0,0 +1,119 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDmsKafkaDumpTaskFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.G42_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid ID format (%s), must be <connector_id>/<task_id>", state.Primary.ID)
+	}
+	getPath := client.Endpoint + "v2/{project_id}/connectors/{connector_id}/sink-tasks/{task_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{connector_id}", parts[0])
+	getPath = strings.ReplaceAll(getPath, "{task_id}", parts[1])
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func TestAccDmsKafkaDumpTask_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "g42cloud_dms_kafka_dump_task.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsKafkaDumpTaskFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaDumpTask_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "topics.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "topics.0",
+						"g42cloud_dms_kafka_topic.topic", "name"),
+					resource.TestCheckResourceAttrPair(resourceName, "obs_bucket_name",
+						"g42cloud_obs_bucket.test", "bucket"),
+					resource.TestCheckResourceAttr(resourceName, "obs_path", "kafka/dump"),
+					resource.TestCheckResourceAttr(resourceName, "partition_format", "yyyy/MM/dd"),
+					resource.TestCheckResourceAttr(resourceName, "deliver_time_interval", "300"),
+					resource.TestCheckResourceAttr(resourceName, "consumer_strategy", "earliest"),
+					resource.TestCheckResourceAttr(resourceName, "destination_file_type", "TEXT"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"agency_name"},
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaDumpTask_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_obs_bucket" "test" {
+  bucket        = "%s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "g42cloud_identity_agency" "test" {
+  name                   = "%s"
+  delegated_service_name = "op_svc_dms"
+  domain_roles           = ["OBS Administrator"]
+}
+
+resource "g42cloud_dms_kafka_smart_connect" "test" {
+  instance_id = g42cloud_dms_kafka_instance.test.id
+}
+
+resource "g42cloud_dms_kafka_dump_task" "test" {
+  connector_id          = g42cloud_dms_kafka_smart_connect.test.connector_id
+  name                  = "%s"
+  topics                = [g42cloud_dms_kafka_topic.topic.name]
+  obs_bucket_name       = g42cloud_obs_bucket.test.bucket
+  obs_path              = "kafka/dump"
+  partition_format      = "yyyy/MM/dd"
+  deliver_time_interval = 300
+  consumer_strategy     = "earliest"
+  agency_name           = g42cloud_identity_agency.test.name
+}
+`, testAccDmsKafkaTopic_basic(rName), rName, rName, rName)
+}
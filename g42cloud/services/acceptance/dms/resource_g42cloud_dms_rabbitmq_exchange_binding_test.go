@@ -0,0 +1,75 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func getDmsRabbitmqExchangeBindingFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	params := map[string]string{
+		"instance_id": state.Primary.Attributes["instance_id"],
+		"vhost":       state.Primary.Attributes["vhost"],
+		"exchange":    state.Primary.Attributes["source"],
+	}
+	return getDmsRabbitmqItem(cfg,
+		"v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges/{exchange}/binding", params,
+		"items", "properties_key", state.Primary.Attributes["properties_key"])
+}
+
+func TestAccDmsRabbitmqExchangeBinding_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_dms_rabbitmq_exchange_binding.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDmsRabbitmqExchangeBindingFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqExchangeBinding_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "source", "g42cloud_dms_rabbitmq_exchange.test", "name"),
+					resource.TestCheckResourceAttr(rName, "destination_type", "Queue"),
+					resource.TestCheckResourceAttrPair(rName, "destination",
+						"g42cloud_dms_rabbitmq_queue.test", "name"),
+					resource.TestCheckResourceAttr(rName, "routing_key", "order.created"),
+					resource.TestCheckResourceAttr(rName, "properties_key", "order.created"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqExchangeBinding_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rabbitmq_exchange_binding" "test" {
+  instance_id      = g42cloud_dms_rabbitmq_instance.test.id
+  vhost            = g42cloud_dms_rabbitmq_vhost.test.name
+  source           = g42cloud_dms_rabbitmq_exchange.test.name
+  destination_type = "Queue"
+  destination      = g42cloud_dms_rabbitmq_queue.test.name
+  routing_key      = "order.created"
+}
+`, testAccDmsRabbitmqQueue_basic(name))
+}
@@ -0,0 +1,71 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func getDmsRabbitmqExchangeFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	params := map[string]string{
+		"instance_id": state.Primary.Attributes["instance_id"],
+		"vhost":       state.Primary.Attributes["vhost"],
+	}
+	return getDmsRabbitmqItem(cfg, "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/exchanges",
+		params, "items", "name", state.Primary.Attributes["name"])
+}
+
+func TestAccDmsRabbitmqExchange_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_dms_rabbitmq_exchange.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDmsRabbitmqExchangeFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqExchange_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "vhost", "g42cloud_dms_rabbitmq_vhost.test", "name"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "type", "topic"),
+					resource.TestCheckResourceAttr(rName, "durable", "true"),
+					resource.TestCheckResourceAttr(rName, "auto_delete", "false"),
+					resource.TestCheckResourceAttr(rName, "internal", "false"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqExchange_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rabbitmq_exchange" "test" {
+  instance_id = g42cloud_dms_rabbitmq_instance.test.id
+  vhost       = g42cloud_dms_rabbitmq_vhost.test.name
+  name        = "%s"
+  type        = "topic"
+}
+`, testAccDmsRabbitmqVhost_basic(name), name)
+}
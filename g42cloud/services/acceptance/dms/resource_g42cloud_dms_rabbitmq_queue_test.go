@@ -0,0 +1,77 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func getDmsRabbitmqQueueFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	params := map[string]string{
+		"instance_id": state.Primary.Attributes["instance_id"],
+		"vhost":       state.Primary.Attributes["vhost"],
+	}
+	return getDmsRabbitmqItem(cfg, "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts/{vhost}/queues",
+		params, "items", "name", state.Primary.Attributes["name"])
+}
+
+func TestAccDmsRabbitmqQueue_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_dms_rabbitmq_queue.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDmsRabbitmqQueueFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqQueue_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "vhost", "g42cloud_dms_rabbitmq_vhost.test", "name"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "durable", "true"),
+					resource.TestCheckResourceAttrPair(rName, "dead_letter_exchange",
+						"g42cloud_dms_rabbitmq_exchange.test", "name"),
+					resource.TestCheckResourceAttr(rName, "dead_letter_routing_key", "dead"),
+					resource.TestCheckResourceAttr(rName, "message_ttl", "60000"),
+					resource.TestCheckResourceAttr(rName, "lazy_mode", "lazy"),
+					resource.TestCheckResourceAttr(rName, "messages", "0"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqQueue_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rabbitmq_queue" "test" {
+  instance_id             = g42cloud_dms_rabbitmq_instance.test.id
+  vhost                   = g42cloud_dms_rabbitmq_vhost.test.name
+  name                    = "%s"
+  dead_letter_exchange    = g42cloud_dms_rabbitmq_exchange.test.name
+  dead_letter_routing_key = "dead"
+  message_ttl             = 60000
+  lazy_mode               = "lazy"
+}
+`, testAccDmsRabbitmqExchange_basic(name), name)
+}
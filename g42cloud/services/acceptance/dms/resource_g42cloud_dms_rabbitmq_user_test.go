@@ -0,0 +1,117 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func getDmsRabbitmqUserFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	params := map[string]string{"instance_id": state.Primary.Attributes["instance_id"]}
+	return getDmsRabbitmqItem(cfg, "v2/{project_id}/instances/{instance_id}/users", params, "users", "access_key",
+		state.Primary.Attributes["access_key"])
+}
+
+func TestAccDmsRabbitmqUser_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_dms_rabbitmq_user.test"
+	password := acceptance.RandomPassword()
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDmsRabbitmqUserFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqUser_basic(name, password),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "access_key", name),
+					resource.TestCheckResourceAttr(rName, "vhosts.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "vhosts.*", map[string]string{
+						"vhost": name,
+						"conf":  "^$",
+						"write": ".*",
+						"read":  ".*",
+					}),
+				),
+			},
+			{
+				Config: testAccDmsRabbitmqUser_update(name, password+"update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "vhosts.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(rName, "vhosts.*", map[string]string{
+						"vhost": "/",
+						"conf":  "^$",
+						"write": "^$",
+						"read":  ".*",
+					}),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqUser_basic(name, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rabbitmq_user" "test" {
+  instance_id = g42cloud_dms_rabbitmq_instance.test.id
+  access_key  = "%s"
+  secret_key  = "%s"
+
+  vhosts {
+    vhost = g42cloud_dms_rabbitmq_vhost.test.name
+    conf  = "^$"
+    write = ".*"
+    read  = ".*"
+  }
+}
+`, testAccDmsRabbitmqVhost_basic(name), name, password)
+}
+
+func testAccDmsRabbitmqUser_update(name, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rabbitmq_user" "test" {
+  instance_id = g42cloud_dms_rabbitmq_instance.test.id
+  access_key  = "%s"
+  secret_key  = "%s"
+
+  vhosts {
+    vhost = g42cloud_dms_rabbitmq_vhost.test.name
+    conf  = "^$"
+    write = ".*"
+    read  = ".*"
+  }
+
+  vhosts {
+    vhost = "/"
+    conf  = "^$"
+    write = "^$"
+    read  = ".*"
+  }
+}
+`, testAccDmsRabbitmqVhost_basic(name), name, password)
+}
@@ -0,0 +1,134 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getDmsRabbitmqItem queries the item whose field equals to the value from the management API of the RabbitMQ
+// instance, the slashes in the values of the path parameters are escaped.
+func getDmsRabbitmqItem(cfg *config.Config, httpUrl string, params map[string]string, key, field,
+	value string) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.G42_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := client.Endpoint + httpUrl
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	for k, v := range params {
+		getPath = strings.ReplaceAll(getPath, fmt.Sprintf("{%s}", k), strings.ReplaceAll(v, "/", "__F_SLASH__"))
+	}
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath+"?offset=0&limit=100", &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	item := utils.PathSearch(fmt.Sprintf("%s[?%s=='%s']|[0]", key, field, value), respBody, nil)
+	if item == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return item, nil
+}
+
+func getDmsRabbitmqVhostFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	params := map[string]string{"instance_id": state.Primary.Attributes["instance_id"]}
+	return getDmsRabbitmqItem(cfg, "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts", params, "items", "name",
+		state.Primary.Attributes["name"])
+}
+
+func TestAccDmsRabbitmqVhost_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_dms_rabbitmq_vhost.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDmsRabbitmqVhostFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRabbitmqVhost_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"g42cloud_dms_rabbitmq_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttrSet(rName, "tracing"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRabbitmqInstance_base(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_availability_zones" "test" {}
+
+data "g42cloud_dms_product" "test" {
+  engine        = "rabbitmq"
+  instance_type = "single"
+  version       = "3.8.35"
+}
+
+resource "g42cloud_dms_rabbitmq_instance" "test" {
+  name               = "%s"
+  vpc_id             = g42cloud_vpc.test.id
+  network_id         = g42cloud_vpc_subnet.test.id
+  security_group_id  = g42cloud_networking_secgroup.test.id
+  availability_zones = [
+    data.g42cloud_availability_zones.test.names[0]
+  ]
+
+  product_id        = data.g42cloud_dms_product.test.id
+  engine_version    = data.g42cloud_dms_product.test.version
+  storage_spec_code = data.g42cloud_dms_product.test.storage_spec_code
+
+  access_user = "user"
+  password    = "Rabbitmqtest@123"
+}
+`, common.TestBaseNetwork(name), name)
+}
+
+func testAccDmsRabbitmqVhost_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rabbitmq_vhost" "test" {
+  instance_id = g42cloud_dms_rabbitmq_instance.test.id
+  name        = "%s"
+}
+`, testAccDmsRabbitmqInstance_base(name), name)
+}
@@ -0,0 +1,76 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func getDmsRocketMQConsumerGroupFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsRocketMQAPI(cfg, "v2/{project_id}/instances/{instance_id}/groups/{group}", state,
+		"instance_id", "group")
+}
+
+func TestAccDmsRocketMQConsumerGroup_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "g42cloud_dms_rocketmq_consumer_group.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsRocketMQConsumerGroupFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRocketMQConsumerGroup_basic(rName, true, 3),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "broadcast", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retry_max_times", "3"),
+				),
+			},
+			{
+				Config: testAccDmsRocketMQConsumerGroup_basic(rName, false, 5),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "broadcast", "false"),
+					resource.TestCheckResourceAttr(resourceName, "retry_max_times", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRocketMQConsumerGroup_basic(rName string, enabled bool, retryTimes int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "g42cloud_dms_rocketmq_consumer_group" "test" {
+  instance_id     = g42cloud_dms_rocketmq_instance.test.id
+  name            = "%[2]s"
+  brokers         = ["broker-0"]
+  enabled         = %[3]t
+  broadcast       = %[3]t
+  retry_max_times = %[4]d
+}
+`, testAccDmsRocketMQInstance_basic(rName, rName, true), rName, enabled, retryTimes)
+}
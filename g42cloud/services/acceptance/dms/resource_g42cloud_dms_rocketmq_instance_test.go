@@ -0,0 +1,215 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getDmsRocketMQAPI queries the RocketMQ API, the path parameters are parsed from the resource ID which consists of
+// the values separated by slashes.
+func getDmsRocketMQAPI(cfg *config.Config, httpUrl string, state *terraform.ResourceState,
+	keys ...string) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.G42_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	parts := strings.SplitN(state.Primary.ID, "/", len(keys))
+	if len(parts) != len(keys) {
+		return nil, fmt.Errorf("invalid ID format, want '%s', but got '%s'", strings.Join(keys, "/"),
+			state.Primary.ID)
+	}
+	getPath := client.Endpoint + httpUrl
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	for i, key := range keys {
+		getPath = strings.ReplaceAll(getPath, fmt.Sprintf("{%s}", key), parts[i])
+	}
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func getDmsRocketMQInstanceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsRocketMQAPI(cfg, "v2/{project_id}/instances/{instance_id}", state, "instance_id")
+}
+
+func TestAccDmsRocketMQInstance_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceNameWithDash()
+	updateName := rName + "-update"
+	resourceName := "g42cloud_dms_rocketmq_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsRocketMQInstanceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRocketMQInstance_basic(rName, rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "enable_acl", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enterprise_project_id", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrPair(resourceName, "flavor_id",
+						"data.g42cloud_dms_rocketmq_flavors.test", "flavors.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "namesrv_address"),
+					resource.TestCheckResourceAttrSet(resourceName, "broker_address"),
+				),
+			},
+			{
+				Config: testAccDmsRocketMQInstance_basic(rName, updateName, false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", updateName),
+					resource.TestCheckResourceAttr(resourceName, "enable_acl", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar_update"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDmsRocketMQInstance_prePaid(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "g42cloud_dms_rocketmq_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsRocketMQInstanceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckChargingMode(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRocketMQInstance_prePaid(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "charging_mode", "prePaid"),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "false"),
+				),
+			},
+			{
+				Config: testAccDmsRocketMQInstance_prePaid(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "auto_renew", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_renew", "period", "period_unit"},
+			},
+		},
+	})
+}
+
+func testAccDmsRocketMQInstance_base(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_dms_rocketmq_availability_zones" "test" {}
+
+data "g42cloud_dms_rocketmq_flavors" "test" {
+  type               = "cluster"
+  availability_zones = [data.g42cloud_dms_rocketmq_availability_zones.test.codes[0]]
+}
+
+locals {
+  flavor = data.g42cloud_dms_rocketmq_flavors.test.flavors[0]
+}
+`, common.TestBaseNetwork(rName))
+}
+
+func testAccDmsRocketMQInstance_basic(rName, name string, enableACL bool) string {
+	tagValue := "bar"
+	if !enableACL {
+		tagValue = "bar_update"
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rocketmq_instance" "test" {
+  name               = "%s"
+  engine_version     = element(data.g42cloud_dms_rocketmq_flavors.test.versions, 0)
+  storage_space      = local.flavor.properties[0].min_broker * local.flavor.properties[0].min_storage_per_node
+  broker_num         = local.flavor.properties[0].min_broker
+  vpc_id             = g42cloud_vpc.test.id
+  subnet_id          = g42cloud_vpc_subnet.test.id
+  security_group_id  = g42cloud_networking_secgroup.test.id
+  availability_zones = [data.g42cloud_dms_rocketmq_availability_zones.test.codes[0]]
+  flavor_id          = local.flavor.id
+  storage_spec_code  = local.flavor.ios[0].storage_spec_code
+  enable_acl         = %t
+
+  tags = {
+    foo = "%s"
+  }
+}
+`, testAccDmsRocketMQInstance_base(rName), name, enableACL, tagValue)
+}
+
+func testAccDmsRocketMQInstance_prePaid(rName string, autoRenew bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rocketmq_instance" "test" {
+  name               = "%s"
+  engine_version     = element(data.g42cloud_dms_rocketmq_flavors.test.versions, 0)
+  storage_space      = local.flavor.properties[0].min_broker * local.flavor.properties[0].min_storage_per_node
+  broker_num         = local.flavor.properties[0].min_broker
+  vpc_id             = g42cloud_vpc.test.id
+  subnet_id          = g42cloud_vpc_subnet.test.id
+  security_group_id  = g42cloud_networking_secgroup.test.id
+  availability_zones = [data.g42cloud_dms_rocketmq_availability_zones.test.codes[0]]
+  flavor_id          = local.flavor.id
+  storage_spec_code  = local.flavor.ios[0].storage_spec_code
+
+  charging_mode = "prePaid"
+  period_unit   = "month"
+  period        = 1
+  auto_renew    = "%t"
+}
+`, testAccDmsRocketMQInstance_base(rName), rName, autoRenew)
+}
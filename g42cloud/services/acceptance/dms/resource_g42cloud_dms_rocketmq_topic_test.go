@@ -0,0 +1,97 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func getDmsRocketMQTopicFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsRocketMQAPI(cfg, "v2/{project_id}/instances/{instance_id}/topics/{topic}", state,
+		"instance_id", "topic")
+}
+
+func TestAccDmsRocketMQTopic_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "g42cloud_dms_rocketmq_topic.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsRocketMQTopicFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRocketMQTopic_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "permission", "all"),
+					resource.TestCheckResourceAttr(resourceName, "total_read_queue_num", "3"),
+					resource.TestCheckResourceAttr(resourceName, "total_write_queue_num", "3"),
+				),
+			},
+			{
+				Config: testAccDmsRocketMQTopic_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "permission", "sub"),
+					resource.TestCheckResourceAttr(resourceName, "total_read_queue_num", "4"),
+					resource.TestCheckResourceAttr(resourceName, "total_write_queue_num", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsRocketMQTopic_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rocketmq_topic" "test" {
+  instance_id = g42cloud_dms_rocketmq_instance.test.id
+  name        = "%s"
+  queue_num   = 3
+  permission  = "all"
+
+  brokers {
+    name = "broker-0"
+  }
+}
+`, testAccDmsRocketMQInstance_basic(rName, rName, true), rName)
+}
+
+func testAccDmsRocketMQTopic_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rocketmq_topic" "test" {
+  instance_id           = g42cloud_dms_rocketmq_instance.test.id
+  name                  = "%s"
+  queue_num             = 3
+  permission            = "sub"
+  total_read_queue_num  = 4
+  total_write_queue_num = 5
+
+  brokers {
+    name = "broker-0"
+  }
+}
+`, testAccDmsRocketMQInstance_basic(rName, rName, true), rName)
+}
@@ -0,0 +1,97 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func getDmsRocketMQUserFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsRocketMQAPI(cfg, "v2/{project_id}/instances/{instance_id}/users/{user_name}", state,
+		"instance_id", "user_name")
+}
+
+func TestAccDmsRocketMQUser_basic(t *testing.T) {
+	var obj interface{}
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "g42cloud_dms_rocketmq_user.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDmsRocketMQUserFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsRocketMQUser_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "access_key", "testRocketmqAK"),
+					resource.TestCheckResourceAttr(resourceName, "admin", "true"),
+				),
+			},
+			{
+				Config: testAccDmsRocketMQUser_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "white_remote_address", "10.10.10.10"),
+					resource.TestCheckResourceAttr(resourceName, "admin", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_topic_perm", "PUB"),
+					resource.TestCheckResourceAttr(resourceName, "default_group_perm", "SUB"),
+					resource.TestCheckResourceAttr(resourceName, "topic_perms.0.name", rName),
+					resource.TestCheckResourceAttr(resourceName, "topic_perms.0.perm", "PUB|SUB"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+		},
+	})
+}
+
+func testAccDmsRocketMQUser_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rocketmq_user" "test" {
+  instance_id = g42cloud_dms_rocketmq_instance.test.id
+  access_key  = "testRocketmqAK"
+  secret_key  = "testRocketmqSK123"
+  admin       = true
+}
+`, testAccDmsRocketMQTopic_basic(rName))
+}
+
+func testAccDmsRocketMQUser_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_dms_rocketmq_user" "test" {
+  instance_id          = g42cloud_dms_rocketmq_instance.test.id
+  access_key           = "testRocketmqAK"
+  secret_key           = "testRocketmqSK123"
+  white_remote_address = "10.10.10.10"
+  admin                = false
+  default_topic_perm   = "PUB"
+  default_group_perm   = "SUB"
+
+  topic_perms {
+    name = g42cloud_dms_rocketmq_topic.test.name
+    perm = "PUB|SUB"
+  }
+}
+`, testAccDmsRocketMQTopic_basic(rName))
+}
@@ -2,6 +2,7 @@ package dms
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/chnsz/golangsdk/openstack/dms/v2/kafka/instances"
@@ -73,6 +74,57 @@ func TestAccKafkaInstance_basic(t *testing.T) {
 	})
 }
 
+func TestAccKafkaInstance_scaling(t *testing.T) {
+	var instance instances.Instance
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "g42cloud_dms_kafka_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getKafkaInstanceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKafkaInstance_scaling(rName, 0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					testAccCheckKafkaInstanceOutput(resourceName, "broker_num"),
+					testAccCheckKafkaInstanceOutput(resourceName, "storage_space"),
+				),
+			},
+			{
+				Config: testAccKafkaInstance_scaling(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					testAccCheckKafkaInstanceOutput(resourceName, "broker_num"),
+					testAccCheckKafkaInstanceOutput(resourceName, "storage_space"),
+				),
+			},
+			{
+				Config:      testAccKafkaInstance_scaling(rName, 0, 2),
+				ExpectError: regexp.MustCompile("the broker number of the Kafka instance cannot be decreased"),
+			},
+		},
+	})
+}
+
+// testAccCheckKafkaInstanceOutput checks whether the attribute of the instance equals the output with the same name.
+func testAccCheckKafkaInstanceOutput(resourceName, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		output, ok := s.RootModule().Outputs[key]
+		if !ok {
+			return fmt.Errorf("output (%s) not found", key)
+		}
+		return resource.TestCheckResourceAttr(resourceName, key, fmt.Sprint(output.Value))(s)
+	}
+}
+
 func testAccKafkaInstance_base(rName string) string {
 	return fmt.Sprintf(`
 data "g42cloud_availability_zones" "test" {}
@@ -166,3 +218,44 @@ resource "g42cloud_dms_kafka_instance" "test" {
 }
 `, testAccKafkaInstance_base(rName), updateName)
 }
+
+// testAccKafkaInstance_scaling adds the extra brokers to the minimum broker number of the flavor, and multiplies the
+// minimum storage space per broker by the storage factor.
+func testAccKafkaInstance_scaling(rName string, extraBrokers, storageFactor int) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_dms_kafka_flavors" "test" {
+  type = "cluster"
+}
+
+locals {
+  flavor        = data.g42cloud_dms_kafka_flavors.test.flavors[0]
+  broker_num    = local.flavor.properties[0].min_broker + %d
+  storage_space = local.broker_num * local.flavor.properties[0].min_storage_per_node * %d
+}
+
+resource "g42cloud_dms_kafka_instance" "test" {
+  name               = "%s"
+  vpc_id             = g42cloud_vpc.test.id
+  network_id         = g42cloud_vpc_subnet.test.id
+  security_group_id  = g42cloud_networking_secgroup.test.id
+  flavor_id          = local.flavor.id
+  storage_spec_code  = local.flavor.ios[0].storage_spec_code
+  availability_zones = [local.flavor.ios[0].availability_zones[0]]
+  engine_version     = data.g42cloud_dms_kafka_flavors.test.versions[0]
+  broker_num         = local.broker_num
+  storage_space      = local.storage_space
+  manager_user       = "kafka-user"
+  manager_password   = "Kafkatest@123"
+}
+
+output "broker_num" {
+  value = local.broker_num
+}
+
+output "storage_space" {
+  value = local.storage_space
+}
+`, testAccKafkaInstance_base(rName), extraBrokers, storageFactor, rName)
+}
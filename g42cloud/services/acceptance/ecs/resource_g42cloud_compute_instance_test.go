@@ -251,6 +251,56 @@ func TestAccComputeInstance_withEPS(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_networking(t *testing.T) {
+	var instance cloudservers.CloudServer
+
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "g42cloud_compute_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_networking(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "network.0.ipv6_enable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "network.0.fixed_ip_v6", ""),
+					resource.TestCheckResourceAttr(resourceName, "network.0.source_dest_check", "true"),
+					resource.TestCheckResourceAttr(resourceName, "network.0.security_group_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network.0.virtual_ip_ids.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "access_ip_v6", ""),
+				),
+			},
+			{
+				Config: testAccComputeInstance_networkingUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "network.0.ipv6_enable", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "network.0.fixed_ip_v6"),
+					resource.TestCheckResourceAttrPair(resourceName, "access_ip_v6",
+						resourceName, "network.0.fixed_ip_v6"),
+					resource.TestCheckResourceAttr(resourceName, "network.0.source_dest_check", "false"),
+					resource.TestCheckResourceAttr(resourceName, "network.0.security_group_ids.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "network.0.virtual_ip_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "network.0.virtual_ip_ids.*",
+						"g42cloud_networking_vip.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"stop_before_destroy", "delete_eip_on_termination", "network.0.virtual_ip_ids",
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckComputeInstanceDestroy(s *terraform.State) error {
 	cfg := acceptance.TestAccProvider.Meta().(*config.Config)
 	computeClient, err := cfg.ComputeV1Client(acceptance.G42_REGION_NAME)
@@ -517,3 +567,73 @@ resource "g42cloud_compute_instance" "test" {
 }
 `, testAccCompute_data, rName)
 }
+
+func testAccComputeInstance_networkingBase(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "g42cloud_vpc" "test" {
+  name = "%[2]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "g42cloud_vpc_subnet" "test" {
+  name        = "%[2]s"
+  vpc_id      = g42cloud_vpc.test.id
+  cidr        = "192.168.0.0/24"
+  gateway_ip  = "192.168.0.1"
+  ipv6_enable = true
+}
+
+resource "g42cloud_networking_secgroup" "test" {
+  count = 2
+  name  = "%[2]s-${count.index}"
+}
+
+resource "g42cloud_networking_vip" "test" {
+  name       = "%[2]s"
+  network_id = g42cloud_vpc_subnet.test.id
+}
+`, testAccCompute_data, rName)
+}
+
+func testAccComputeInstance_networking(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_compute_instance" "test" {
+  name                = "%s"
+  image_id            = data.g42cloud_images_image.test.id
+  flavor_id           = data.g42cloud_compute_flavors.test.ids[0]
+  availability_zone   = data.g42cloud_availability_zones.test.names[0]
+  stop_before_destroy = true
+
+  network {
+    uuid               = g42cloud_vpc_subnet.test.id
+    security_group_ids = [g42cloud_networking_secgroup.test[0].id]
+  }
+}
+`, testAccComputeInstance_networkingBase(rName), rName)
+}
+
+func testAccComputeInstance_networkingUpdate(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "g42cloud_compute_instance" "test" {
+  name                = "%s"
+  image_id            = data.g42cloud_images_image.test.id
+  flavor_id           = data.g42cloud_compute_flavors.test.ids[0]
+  availability_zone   = data.g42cloud_availability_zones.test.names[0]
+  stop_before_destroy = true
+
+  network {
+    uuid               = g42cloud_vpc_subnet.test.id
+    ipv6_enable        = true
+    source_dest_check  = false
+    security_group_ids = g42cloud_networking_secgroup.test[*].id
+    virtual_ip_ids     = [g42cloud_networking_vip.test.id]
+  }
+}
+`, testAccComputeInstance_networkingBase(rName), rName)
+}
@@ -0,0 +1,47 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccDataSourceRdsBackups_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	dataSourceName := "data.g42cloud_rds_backups.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRdsBackups_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "backups.0.id", "g42cloud_rds_backup.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.0.type", "manual"),
+					resource.TestCheckResourceAttr(dataSourceName, "backups.0.status", "COMPLETED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRdsBackups_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_rds_backups" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+  backup_type = "manual"
+  status      = "COMPLETED"
+
+  depends_on = [g42cloud_rds_backup.test]
+}
+`, testAccRdsBackup_basic(name))
+}
@@ -0,0 +1,52 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccDataSourceRdsInstances_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	dataSourceName := "data.g42cloud_rds_instances.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRdsInstances_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "instances.0.id",
+						"g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.db.0.type", "PostgreSQL"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.port", "8635"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.flavor", "rds.pg.c6.large.4"),
+					resource.TestCheckResourceAttr(dataSourceName, "instances.0.nodes.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.private_ips.0"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "instances.0.status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRdsInstances_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_rds_instances" "test" {
+  name           = g42cloud_rds_instance.test.name
+  datastore_type = "PostgreSQL"
+  vpc_id         = g42cloud_vpc.test.id
+  subnet_id      = g42cloud_vpc_subnet.test.id
+}
+`, testAccRdsInstance_base(name))
+}
@@ -0,0 +1,44 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccDataSourceRdsRestoreTimeRanges_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	dataSourceName := "data.g42cloud_rds_restore_time_ranges.test"
+	dc := acceptance.InitDataSourceCheck(dataSourceName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceRdsRestoreTimeRanges_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(dataSourceName, "restore_time_ranges.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "restore_time_ranges.0.start_time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "restore_time_ranges.0.end_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceRdsRestoreTimeRanges_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+data "g42cloud_rds_restore_time_ranges" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+
+  depends_on = [g42cloud_rds_backup.test]
+}
+`, testAccRdsBackup_basic(name))
+}
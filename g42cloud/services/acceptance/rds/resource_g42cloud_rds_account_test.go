@@ -0,0 +1,73 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+func getAccountResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getRdsInstanceItem(cfg, state, "v3/{project_id}/instances/{instance_id}/db_user/detail?page=1&limit=100",
+		"users")
+}
+
+func TestAccRdsAccount_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_rds_account.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getAccountResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsAccount_basic(name, "Test@12345678"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "engine", "postgresql"),
+				),
+			},
+			{
+				Config: testAccRdsAccount_basic(name, "Test@87654321"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
+		},
+	})
+}
+
+func testAccRdsAccount_basic(name, password string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "g42cloud_rds_account" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+  name        = "%[2]s"
+  password    = "%[3]s"
+}
+`, testAccRdsInstance_base(name), name, password)
+}
@@ -0,0 +1,149 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getBackupResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("rds", acceptance.G42_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	getBackupPath := client.Endpoint + "v3/{project_id}/backups"
+	getBackupPath = strings.ReplaceAll(getBackupPath, "{project_id}", client.ProjectID)
+	getBackupPath += fmt.Sprintf("?instance_id=%s&backup_id=%s", state.Primary.Attributes["instance_id"],
+		state.Primary.ID)
+	getBackupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getBackupPath, &getBackupOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving RDS backup: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving RDS backup: %s", err)
+	}
+
+	backup := utils.PathSearch("backups|[0]", respBody, nil)
+	if backup == nil {
+		return nil, fmt.Errorf("unable to find the RDS backup (%s)", state.Primary.ID)
+	}
+	return backup, nil
+}
+
+func TestAccRdsBackup_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_rds_backup.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getBackupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsBackup_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet(rName, "begin_time"),
+					resource.TestCheckResourceAttrSet(rName, "end_time"),
+					resource.TestCheckResourceAttrSet(rName, "size"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccRdsBackupImportStateFunc(rName),
+			},
+		},
+	})
+}
+
+// testAccRdsInstance_base can be referred as `g42cloud_rds_instance.test`, the automated backup is ignored to prevent
+// the instance status from changing to "BACKING UP" during the test.
+func testAccRdsInstance_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "g42cloud_availability_zones" "test" {}
+
+resource "g42cloud_rds_instance" "test" {
+  name              = "%[2]s"
+  flavor            = "rds.pg.c6.large.4"
+  availability_zone = [data.g42cloud_availability_zones.test.names[0]]
+  security_group_id = g42cloud_networking_secgroup.test.id
+  subnet_id         = g42cloud_vpc_subnet.test.id
+  vpc_id            = g42cloud_vpc.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "11"
+    port     = 8635
+  }
+  volume {
+    type = "ULTRAHIGH"
+    size = 50
+  }
+  backup_strategy {
+    start_time = "08:00-09:00"
+    keep_days  = 1
+  }
+
+  lifecycle {
+    ignore_changes = [
+      backup_strategy,
+    ]
+  }
+}
+`, common.TestBaseNetwork(name), name)
+}
+
+func testAccRdsBackup_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "g42cloud_rds_backup" "test" {
+  name        = "%[2]s"
+  instance_id = g42cloud_rds_instance.test.id
+}
+`, testAccRdsInstance_base(name), name)
+}
+
+func testAccRdsBackupImportStateFunc(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", name)
+		}
+		if rs.Primary.ID == "" || rs.Primary.Attributes["instance_id"] == "" {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
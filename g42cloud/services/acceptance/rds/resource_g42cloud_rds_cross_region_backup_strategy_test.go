@@ -0,0 +1,105 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getBackupStrategyResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("rds", acceptance.G42_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/backups/offsite-policy"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{instance_id}", state.Primary.ID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving RDS cross-region backup strategy: %s", err)
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving RDS cross-region backup strategy: %s", err)
+	}
+
+	if utils.PathSearch("policy_para|[0].keep_days", respBody, float64(0)).(float64) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return respBody, nil
+}
+
+func TestAccRdsCrossRegionBackupStrategy_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_rds_cross_region_backup_strategy.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getBackupStrategyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckReplication(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsCrossRegionBackupStrategy_basic(name, "auto", 5),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "backup_type", "auto"),
+					resource.TestCheckResourceAttr(rName, "keep_days", "5"),
+					resource.TestCheckResourceAttr(rName, "destination_region", acceptance.G42_DEST_REGION),
+					resource.TestCheckResourceAttr(rName, "destination_project_id", acceptance.G42_DEST_PROJECT_ID),
+				),
+			},
+			{
+				Config: testAccRdsCrossRegionBackupStrategy_basic(name, "all", 8),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "backup_type", "all"),
+					resource.TestCheckResourceAttr(rName, "keep_days", "8"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRdsCrossRegionBackupStrategy_basic(name, backupType string, keepDays int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "g42cloud_rds_cross_region_backup_strategy" "test" {
+  instance_id            = g42cloud_rds_instance.test.id
+  backup_type            = "%[2]s"
+  keep_days              = %[3]d
+  destination_region     = "%[4]s"
+  destination_project_id = "%[5]s"
+}
+`, testAccRdsInstance_base(name), backupType, keepDays, acceptance.G42_DEST_REGION, acceptance.G42_DEST_PROJECT_ID)
+}
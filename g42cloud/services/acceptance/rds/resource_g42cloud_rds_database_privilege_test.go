@@ -0,0 +1,67 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccRdsDatabasePrivilege_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_rds_database_privilege.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsDatabasePrivilege_basic(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "db_name", "g42cloud_rds_database.test", "name"),
+					resource.TestCheckResourceAttr(rName, "users.#", "1"),
+					resource.TestCheckResourceAttr(rName, "users.0.readonly", "false"),
+					resource.TestCheckResourceAttr(rName, "users.0.schema_name", "public"),
+				),
+			},
+			{
+				Config: testAccRdsDatabasePrivilege_basic(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "users.#", "1"),
+					resource.TestCheckResourceAttr(rName, "users.0.readonly", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRdsDatabasePrivilege_basic(name string, readonly bool) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "g42cloud_rds_database" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+  name        = "%[2]s"
+}
+
+resource "g42cloud_rds_account" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+  name        = "%[2]s"
+  password    = "Test@12345678"
+}
+
+resource "g42cloud_rds_database_privilege" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+  db_name     = g42cloud_rds_database.test.name
+
+  users {
+    name        = g42cloud_rds_account.test.name
+    readonly    = %[3]t
+    schema_name = "public"
+  }
+}
+`, testAccRdsInstance_base(name), name, readonly)
+}
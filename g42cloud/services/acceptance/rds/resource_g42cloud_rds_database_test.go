@@ -0,0 +1,103 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getRdsInstanceItem queries the item named as the second part of the resource ID, such as the database and account.
+func getRdsInstanceItem(cfg *config.Config, state *terraform.ResourceState, httpUrl, key string) (interface{}, error) {
+	client, err := cfg.NewServiceClient("rds", acceptance.G42_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS client: %s", err)
+	}
+
+	parts := strings.SplitN(state.Primary.ID, "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid ID format (%s)", state.Primary.ID)
+	}
+	getPath := client.Endpoint + httpUrl
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{instance_id}", parts[0])
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	item := utils.PathSearch(fmt.Sprintf("%s[?name=='%s']|[0]", key, parts[1]), respBody, nil)
+	if item == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return item, nil
+}
+
+func getDatabaseResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getRdsInstanceItem(cfg, state, "v3/{project_id}/instances/{instance_id}/database/detail?page=1&limit=100",
+		"databases")
+}
+
+func TestAccRdsDatabase_basic(t *testing.T) {
+	var obj interface{}
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_rds_database.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDatabaseResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsDatabase_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "character_set", "UTF8"),
+					resource.TestCheckResourceAttr(rName, "owner", "root"),
+					resource.TestCheckResourceAttr(rName, "engine", "postgresql"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRdsDatabase_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "g42cloud_rds_database" "test" {
+  instance_id   = g42cloud_rds_instance.test.id
+  name          = "%[2]s"
+  character_set = "UTF8"
+}
+`, testAccRdsInstance_base(name), name)
+}
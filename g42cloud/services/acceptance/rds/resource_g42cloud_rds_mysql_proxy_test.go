@@ -0,0 +1,99 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance/common"
+)
+
+func TestAccRdsMysqlProxy_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_rds_mysql_proxy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsMysqlProxy_basic(name, 30, 50),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "node_num", "2"),
+					resource.TestCheckResourceAttr(rName, "delay_threshold_in_seconds", "30"),
+					resource.TestCheckResourceAttr(rName, "master_node_weight", "50"),
+					resource.TestCheckResourceAttr(rName, "readonly_nodes_weight.#", "1"),
+					resource.TestCheckResourceAttrSet(rName, "proxy_id"),
+					resource.TestCheckResourceAttrSet(rName, "address"),
+					resource.TestCheckResourceAttrSet(rName, "port"),
+				),
+			},
+			{
+				Config: testAccRdsMysqlProxy_basic(name, 60, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "delay_threshold_in_seconds", "60"),
+					resource.TestCheckResourceAttr(rName, "master_node_weight", "20"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRdsMysqlProxy_basic(name string, delayThreshold, masterWeight int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "g42cloud_availability_zones" "test" {}
+
+resource "g42cloud_rds_instance" "test" {
+  name              = "%[2]s"
+  flavor            = "rds.mysql.c6.large.2"
+  availability_zone = [data.g42cloud_availability_zones.test.names[0]]
+  security_group_id = g42cloud_networking_secgroup.test.id
+  subnet_id         = g42cloud_vpc_subnet.test.id
+  vpc_id            = g42cloud_vpc.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "MySQL"
+    version  = "8.0"
+  }
+  volume {
+    type = "ULTRAHIGH"
+    size = 50
+  }
+}
+
+resource "g42cloud_rds_read_replica_instance" "test" {
+  name                = "%[2]s-replica"
+  flavor              = "rds.mysql.c6.large.2.rr"
+  primary_instance_id = g42cloud_rds_instance.test.id
+  availability_zone   = data.g42cloud_availability_zones.test.names[0]
+
+  volume {
+    type = "ULTRAHIGH"
+  }
+}
+
+resource "g42cloud_rds_mysql_proxy" "test" {
+  instance_id                = g42cloud_rds_instance.test.id
+  flavor                     = "rds.proxy.large.2"
+  node_num                   = 2
+  delay_threshold_in_seconds = %[3]d
+  master_node_weight         = %[4]d
+
+  readonly_nodes_weight {
+    id     = g42cloud_rds_read_replica_instance.test.id
+    weight = 50
+  }
+}
+`, common.TestBaseNetwork(name), name, delayThreshold, masterWeight)
+}
@@ -0,0 +1,72 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccRdsPgAccountRoles_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_rds_pg_account_roles.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsPgAccountRoles_basic(name, "g42cloud_rds_account.role1.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "user", "g42cloud_rds_account.member", "name"),
+					resource.TestCheckResourceAttr(rName, "roles.#", "1"),
+				),
+			},
+			{
+				Config: testAccRdsPgAccountRoles_basic(name,
+					"g42cloud_rds_account.role1.name, g42cloud_rds_account.role2.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rName, "roles.#", "2"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRdsPgAccountRoles_basic(name, roles string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "g42cloud_rds_account" "member" {
+  instance_id = g42cloud_rds_instance.test.id
+  name        = "%[2]s_member"
+  password    = "Test@12345678"
+}
+
+resource "g42cloud_rds_account" "role1" {
+  instance_id = g42cloud_rds_instance.test.id
+  name        = "%[2]s_role1"
+  password    = "Test@12345678"
+}
+
+resource "g42cloud_rds_account" "role2" {
+  instance_id = g42cloud_rds_instance.test.id
+  name        = "%[2]s_role2"
+  password    = "Test@12345678"
+}
+
+resource "g42cloud_rds_pg_account_roles" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+  user        = g42cloud_rds_account.member.name
+  roles       = [%[3]s]
+}
+`, testAccRdsInstance_base(name), name, roles)
+}
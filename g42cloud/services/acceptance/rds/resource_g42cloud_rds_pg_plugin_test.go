@@ -0,0 +1,53 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/services/acceptance"
+)
+
+func TestAccRdsPgPlugin_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	rName := "g42cloud_rds_pg_plugin.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsPgPlugin_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "g42cloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "database_name", "g42cloud_rds_database.test", "name"),
+					resource.TestCheckResourceAttr(rName, "name", "pgcrypto"),
+					resource.TestCheckResourceAttrSet(rName, "version"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccRdsPgPlugin_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "g42cloud_rds_database" "test" {
+  instance_id = g42cloud_rds_instance.test.id
+  name        = "%[2]s"
+}
+
+resource "g42cloud_rds_pg_plugin" "test" {
+  instance_id   = g42cloud_rds_instance.test.id
+  database_name = g42cloud_rds_database.test.name
+  name          = "pgcrypto"
+}
+`, testAccRdsInstance_base(name), name)
+}
@@ -1,11 +1,23 @@
 package main
 
 import (
+	"fmt"
+	"os"
+
 	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud"
+	"github.com/g42cloud-terraform/terraform-provider-g42cloud/g42cloud/generate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		if err := generate.Run(os.Args[2:], os.Stdout, os.Stderr); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: g42cloud.Provider})
 }